@@ -27,8 +27,9 @@ func ResourceRuleGroupNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"data": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validRuleGroupNamespaceData,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -0,0 +1,64 @@
+package amp
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+type ruleGroupsNamespaceData struct {
+	Groups []ruleGroup `yaml:"groups"`
+}
+
+type ruleGroup struct {
+	Name  string                   `yaml:"name"`
+	Rules []map[string]interface{} `yaml:"rules"`
+}
+
+// validRuleGroupNamespaceData checks that the value is a Prometheus rules file: a top-level
+// "groups" list of uniquely named groups whose rules each have an "expr" and exactly one of
+// "record" or "alert". The service accepts other input and only reports it asynchronously.
+func validRuleGroupNamespaceData(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var data ruleGroupsNamespaceData
+
+	if err := yaml.Unmarshal([]byte(value), &data); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains invalid YAML: %w", k, err))
+		return
+	}
+
+	if len(data.Groups) == 0 {
+		errors = append(errors, fmt.Errorf("%q must contain at least one rule group in %q", k, "groups"))
+		return
+	}
+
+	names := make(map[string]struct{})
+
+	for i, group := range data.Groups {
+		if group.Name == "" {
+			errors = append(errors, fmt.Errorf("%q: rule group %d has no name", k, i))
+			continue
+		}
+
+		if _, ok := names[group.Name]; ok {
+			errors = append(errors, fmt.Errorf("%q: rule group name %q is not unique", k, group.Name))
+		}
+		names[group.Name] = struct{}{}
+
+		for j, rule := range group.Rules {
+			_, record := rule["record"]
+			_, alert := rule["alert"]
+
+			if record == alert {
+				errors = append(errors, fmt.Errorf("%q: rule %d in group %q must set exactly one of %q or %q", k, j, group.Name, "record", "alert"))
+			}
+
+			if _, ok := rule["expr"]; !ok {
+				errors = append(errors, fmt.Errorf("%q: rule %d in group %q has no %q", k, j, group.Name, "expr"))
+			}
+		}
+	}
+
+	return
+}
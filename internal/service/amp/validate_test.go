@@ -0,0 +1,84 @@
+package amp
+
+import (
+	"testing"
+)
+
+func TestValidRuleGroupNamespaceData(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - alert: HighRequestLatency
+      expr: job:request_latency_seconds:mean5m{job="myjob"} > 0.5
+      for: 10m
+  - name: empty
+    rules: []
+`,
+	}
+	for _, v := range validValues {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) != 0 {
+			t.Errorf("%q should be valid rule group namespace data: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		``,
+		`not: [valid`,
+		`
+rules:
+  - record: metric:recording_rule
+    expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - rules:
+    - record: metric:recording_rule
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - record: metric:recording_rule
+      alert: HighRequestLatency
+      expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - name: test
+    rules:
+    - expr: avg(rate(container_cpu_usage_seconds_total[5m]))
+`,
+		`
+groups:
+  - name: test
+    rules: []
+  - name: test
+    rules: []
+`,
+	}
+	for _, v := range invalidValues {
+		_, errors := validRuleGroupNamespaceData(v, "data")
+		if len(errors) == 0 {
+			t.Errorf("%q should be invalid rule group namespace data", v)
+		}
+	}
+}
@@ -103,6 +103,10 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *prometheusservice.
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.RuleGroupsNamespaceStatusCodeCreationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.StatusReason)))
+		}
+
 		return output, err
 	}
 
@@ -120,6 +124,10 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *prometheusservice.
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.RuleGroupsNamespaceStatusCodeUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.StatusReason)))
+		}
+
 		return output, err
 	}
 
@@ -58,8 +58,9 @@ func ResourceUsagePlan() *schema.Resource {
 										Optional: true,
 									},
 									"path": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validUsagePlanThrottlePath,
 									},
 									"rate_limit": {
 										Type:     schema.TypeFloat,
@@ -232,10 +233,8 @@ func resourceUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s): %s", d.Id(), err)
 	}
 
-	if up.ApiStages != nil {
-		if err := d.Set("api_stages", flattenAPIStages(up.ApiStages)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting api_stages: %s", err)
-		}
+	if err := d.Set("api_stages", flattenAPIStages(up.ApiStages)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting api_stages: %s", err)
 	}
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -247,15 +246,11 @@ func resourceUsagePlanRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("description", up.Description)
 	d.Set("name", up.Name)
 	d.Set("product_code", up.ProductCode)
-	if up.Quota != nil {
-		if err := d.Set("quota_settings", flattenQuotaSettings(up.Quota)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting quota_settings: %s", err)
-		}
+	if err := d.Set("quota_settings", flattenQuotaSettings(up.Quota)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting quota_settings: %s", err)
 	}
-	if up.Throttle != nil {
-		if err := d.Set("throttle_settings", flattenThrottleSettings(up.Throttle)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting throttle_settings: %s", err)
-		}
+	if err := d.Set("throttle_settings", flattenThrottleSettings(up.Throttle)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting throttle_settings: %s", err)
 	}
 
 	tags := KeyValueTags(ctx, up.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
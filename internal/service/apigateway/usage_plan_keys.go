@@ -0,0 +1,199 @@
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	usagePlanKeyTypeAPIKey = "API_KEY"
+)
+
+// @SDKResource("aws_api_gateway_usage_plan_keys")
+func ResourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("usage_plan_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+
+	if err := createUsagePlanKeys(ctx, conn, usagePlanID, flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan (%s) Keys: %s", usagePlanID, err)
+	}
+
+	d.SetId(usagePlanID)
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	upks, err := FindUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if err == nil && len(upks) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan (%s) Keys not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+	}
+
+	var keyIDs []string
+
+	for _, upk := range upks {
+		keyIDs = append(keyIDs, aws.StringValue(upk.Id))
+	}
+
+	d.Set("key_ids", keyIDs)
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := deleteUsagePlanKeys(ctx, conn, d.Id(), flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+		}
+
+		if err := createUsagePlanKeys(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	log.Printf("[DEBUG] Deleting API Gateway Usage Plan Keys: %s", d.Id())
+	if err := deleteUsagePlanKeys(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan (%s) Keys: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func createUsagePlanKeys(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string, keyIDs []string) error {
+	for _, keyID := range keyIDs {
+		input := &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String(usagePlanKeyTypeAPIKey),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		// Associating a key that is already in the plan returns a ConflictException.
+		if _, err := conn.CreateUsagePlanKeyWithContext(ctx, input); err != nil && !tfawserr.ErrCodeEquals(err, apigateway.ErrCodeConflictException) {
+			return fmt.Errorf("adding key (%s): %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteUsagePlanKeys(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string, keyIDs []string) error {
+	for _, keyID := range keyIDs {
+		input := &apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		if _, err := conn.DeleteUsagePlanKeyWithContext(ctx, input); err != nil && !tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			return fmt.Errorf("removing key (%s): %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+func FindUsagePlanKeysByUsagePlanID(ctx context.Context, conn *apigateway.APIGateway, usagePlanID string) ([]*apigateway.UsagePlanKey, error) {
+	input := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	}
+	var output []*apigateway.UsagePlanKey
+
+	err := conn.GetUsagePlanKeysPagesWithContext(ctx, input, func(page *apigateway.GetUsagePlanKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -2,6 +2,8 @@ package apigateway
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,3 +48,30 @@ func validUsagePlanQuotaSettings(v map[string]interface{}) (errors []error) {
 
 	return
 }
+
+// validUsagePlanThrottlePath validates a usage plan method throttling path.
+// The path is the resource path followed by the HTTP method, e.g. "/pets/GET" or "/*/*".
+func validUsagePlanThrottlePath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	i := strings.LastIndex(value, "/")
+
+	if !strings.HasPrefix(value, "/") || i < 1 {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a resource path followed by an HTTP method, e.g. \"/pets/GET\"", k, value))
+		return
+	}
+
+	resourcePath, method := value[:i], value[i+1:]
+
+	if !regexp.MustCompile(`^/[^\s]*$`).MatchString(resourcePath) {
+		errors = append(errors, fmt.Errorf("%q (%s) contains an invalid resource path: %q", k, value, resourcePath))
+	}
+
+	if method != "*" {
+		if _, es := validHTTPMethod()(method, k); len(es) > 0 {
+			errors = append(errors, fmt.Errorf("%q (%s) contains an invalid HTTP method %q, expected \"*\" or one of ANY, DELETE, GET, HEAD, OPTIONS, PATCH, POST, PUT", k, value, method))
+		}
+	}
+
+	return
+}
@@ -80,3 +80,38 @@ func TestValidUsagePlanQuotaSettings(t *testing.T) {
 		}
 	}
 }
+
+func TestValidUsagePlanThrottlePath(t *testing.T) {
+	t.Parallel()
+
+	validPaths := []string{
+		"/*/*",
+		"/pets/GET",
+		"/pets/{petId}/DELETE",
+		"//GET",
+		"/pets/*",
+	}
+	for _, v := range validPaths {
+		_, errors := validUsagePlanThrottlePath(v, "path")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid usage plan throttle path: %q", v, errors)
+		}
+	}
+
+	invalidPaths := []string{
+		"",
+		"GET",
+		"GET /pets",
+		"pets/GET",
+		"/GET",
+		"/pets/get",
+		"/pets/FETCH",
+		"/pets /GET",
+	}
+	for _, v := range invalidPaths {
+		_, errors := validUsagePlanThrottlePath(v, "path")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid usage plan throttle path", v)
+		}
+	}
+}
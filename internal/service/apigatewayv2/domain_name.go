@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -144,10 +145,14 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(output.DomainName))
 
-	if _, err := waitDomainNameAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	waitOutput, err := waitDomainNameAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Domain Name (%s) create: %s", d.Id(), err)
 	}
 
+	diags = appendTruststoreWarnings(diags, d.Id(), waitOutput)
+
 	return append(diags, resourceDomainNameRead(ctx, d, meta)...)
 }
 
@@ -211,17 +216,8 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		if d.HasChange("mutual_tls_authentication") {
 			if v, ok := d.GetOk("mutual_tls_authentication"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				tfMap := v.([]interface{})[0].(map[string]interface{})
-
-				input.MutualTlsAuthentication = &apigatewayv2.MutualTlsAuthenticationInput{}
-
-				if d.HasChange("mutual_tls_authentication.0.truststore_uri") {
-					input.MutualTlsAuthentication.TruststoreUri = aws.String(tfMap["truststore_uri"].(string))
-				}
-
-				if d.HasChange("mutual_tls_authentication.0.truststore_version") {
-					input.MutualTlsAuthentication.TruststoreVersion = aws.String(tfMap["truststore_version"].(string))
-				}
+				// The truststore URI must accompany the version, otherwise a version-only change is not applied.
+				input.MutualTlsAuthentication = expandMutualTLSAuthentication(v.([]interface{}))
 			} else {
 				// To disable mutual TLS for a custom domain name, remove the truststore from your custom domain name.
 				input.MutualTlsAuthentication = &apigatewayv2.MutualTlsAuthenticationInput{
@@ -236,9 +232,13 @@ func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta
 			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 Domain Name (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDomainNameAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		output, err := waitDomainNameAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for API Gateway v2 Domain Name (%s) update: %s", d.Id(), err)
 		}
+
+		diags = appendTruststoreWarnings(diags, d.Id(), output)
 	}
 
 	if d.HasChange("tags_all") {
@@ -331,6 +331,15 @@ func waitDomainNameAvailable(ctx context.Context, conn *apigatewayv2.ApiGatewayV
 	return nil, err
 }
 
+// appendTruststoreWarnings surfaces the certificates in a domain name's truststore that API Gateway couldn't use.
+func appendTruststoreWarnings(diags diag.Diagnostics, name string, output *apigatewayv2.GetDomainNameOutput) diag.Diagnostics {
+	if output == nil || output.MutualTlsAuthentication == nil || len(output.MutualTlsAuthentication.TruststoreWarnings) == 0 {
+		return diags
+	}
+
+	return sdkdiag.AppendWarningf(diags, "API Gateway v2 Domain Name (%s) truststore warnings: %s", name, strings.Join(aws.StringValueSlice(output.MutualTlsAuthentication.TruststoreWarnings), ", "))
+}
+
 func expandDomainNameConfiguration(tfMap map[string]interface{}) *apigatewayv2.DomainNameConfiguration {
 	if tfMap == nil {
 		return nil
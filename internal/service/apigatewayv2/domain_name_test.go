@@ -236,7 +236,7 @@ func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckDomainNameDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain),
+				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain, 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainNameExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", regexp.MustCompile(`/domainnames/.+`)),
@@ -253,8 +253,9 @@ func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
+			// Test rotating the truststore object version.
 			{
-				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain),
+				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain, 2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainNameExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", regexp.MustCompile(`/domainnames/.+`)),
@@ -294,7 +295,7 @@ func TestAccAPIGatewayV2DomainName_MutualTLSAuthentication_basic(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain),
+				Config: testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain, 2),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDomainNameExists(ctx, resourceName, &v),
 					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "apigateway", regexp.MustCompile(`/domainnames/.+`)),
@@ -582,7 +583,7 @@ resource "aws_apigatewayv2_domain_name" "test" {
 `, rName))
 }
 
-func testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain string) string {
+func testAccDomainNameConfig_mutualTLSAuthenticationObjectVersion(rName, rootDomain, domain string, truststore int) string {
 	return acctest.ConfigCompose(
 		testAccDomainNamePublicCertConfig(rootDomain, domain),
 		fmt.Sprintf(`
@@ -602,7 +603,7 @@ resource "aws_s3_bucket_versioning" "test" {
 resource "aws_s3_object" "test" {
   bucket = aws_s3_bucket_versioning.test.bucket
   key    = %[1]q
-  source = "test-fixtures/apigateway-domain-name-truststore-1.pem"
+  source = "test-fixtures/apigateway-domain-name-truststore-%[2]d.pem"
 }
 
 resource "aws_apigatewayv2_domain_name" "test" {
@@ -619,7 +620,7 @@ resource "aws_apigatewayv2_domain_name" "test" {
     truststore_version = aws_s3_object.test.version_id
   }
 }
-`, rName))
+`, rName, truststore))
 }
 
 func testAccDomainNameConfig_mutualTLSAuthenticationMissing(rootDomain, domain string) string {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -170,6 +171,8 @@ func ResourceIntegration() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceIntegrationParametersCustomizeDiff,
 	}
 }
 
@@ -446,6 +449,55 @@ func resourceIntegrationImport(ctx context.Context, d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceIntegrationParametersCustomizeDiff validates HTTP API parameter mappings at plan time.
+// WebSocket API (integration.request.*) and AWS service integration (integration_subtype) request parameters are not validated.
+func resourceIntegrationParametersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("request_parameters") && d.Get("integration_subtype").(string) == "" {
+		m := d.Get("request_parameters").(map[string]interface{})
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if strings.HasPrefix(k, "integration.") {
+				continue
+			}
+
+			if err := validIntegrationRequestParameter(k, m[k].(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.NewValueKnown("response_parameters") {
+		for _, tfMapRaw := range d.Get("response_parameters").(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			statusCode, _ := tfMap["status_code"].(string)
+			m, _ := tfMap["mappings"].(map[string]interface{})
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				if err := validIntegrationResponseParameter(statusCode, k, m[k].(string)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandTLSConfig(vConfig []interface{}) *apigatewayv2.TlsConfigInput {
 	config := &apigatewayv2.TlsConfigInput{}
 
@@ -3,6 +3,7 @@ package apigatewayv2_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -226,6 +227,32 @@ func TestAccAPIGatewayV2Integration_dataMappingHTTP(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2Integration_dataMappingHTTPInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIntegrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationConfig_dataMappingHTTPInvalid(rName, "replace:header.header1", "$context.requestId", "overwrite:statuscode", "403"),
+				ExpectError: regexp.MustCompile(`invalid request parameter mapping key \(replace:header.header1\)`),
+			},
+			{
+				Config:      testAccIntegrationConfig_dataMappingHTTPInvalid(rName, "append:header.header1", "$request.headers.x-user", "overwrite:statuscode", "403"),
+				ExpectError: regexp.MustCompile(`invalid request parameter mapping \(append:header.header1\) value`),
+			},
+			{
+				Config:      testAccIntegrationConfig_dataMappingHTTPInvalid(rName, "append:header.header1", "$context.requestId", "overwrite:querystring.qs1", "value"),
+				ExpectError: regexp.MustCompile(`invalid response parameter mapping key \(overwrite:querystring.qs1\)`),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayV2Integration_integrationTypeHTTP(t *testing.T) {
 	ctx := acctest.Context(t)
 	var apiId string
@@ -872,6 +899,30 @@ resource "aws_apigatewayv2_integration" "test" {
 `
 }
 
+func testAccIntegrationConfig_dataMappingHTTPInvalid(rName, requestKey, requestValue, responseKey, responseValue string) string {
+	return testAccIntegrationConfig_apiHTTP(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_integration" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  integration_type   = "HTTP_PROXY"
+  integration_method = "ANY"
+  integration_uri    = "http://www.example.com"
+
+  request_parameters = {
+    %[1]q = %[2]q
+  }
+
+  response_parameters {
+    status_code = "500"
+
+    mappings = {
+      %[3]q = %[4]q
+    }
+  }
+}
+`, requestKey, requestValue, responseKey, responseValue)
+}
+
 func testAccIntegrationConfig_typeHTTP(rName string) string {
 	return testAccIntegrationConfig_apiWebSocket(rName) + `
 resource "aws_apigatewayv2_integration" "test" {
@@ -1,6 +1,9 @@
 package apigatewayv2
 
 import (
+	"fmt"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,3 +20,48 @@ func validHTTPMethod() schema.SchemaValidateFunc {
 		"PUT",
 	}, false)
 }
+
+var (
+	integrationRequestParameterKeyRegexp  = regexp.MustCompile(`^(append|overwrite|remove):(header\.\S+|querystring\.\S+|path)$`)
+	integrationResponseParameterKeyRegexp = regexp.MustCompile(`^((append|overwrite|remove):header\.\S+|overwrite:statuscode)$`)
+	integrationRequestSourceRegexp        = regexp.MustCompile(`\$request\.[^\s,]*`)
+	integrationRequestSourceValidRegexp   = regexp.MustCompile(`^\$request\.(header\.[^\s.]|querystring\.[^\s.]|path($|\.[^\s.])|body($|\.|\[))`)
+	integrationResponseSourceRegexp       = regexp.MustCompile(`\$response\.[^\s,]*`)
+	integrationResponseSourceValidRegexp  = regexp.MustCompile(`^\$response\.(header\.[^\s.]|body($|\.|\[))`)
+	integrationResponseStatusCodeRegexp   = regexp.MustCompile(`^[2-5][0-9]{2}$`)
+)
+
+// validIntegrationRequestParameter validates an HTTP API integration request parameter mapping.
+// See https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-parameter-mapping.html.
+func validIntegrationRequestParameter(key, value string) error {
+	if !integrationRequestParameterKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid request parameter mapping key (%s), expected <append|overwrite|remove>:<header.name|querystring.name|path>", key)
+	}
+
+	for _, v := range integrationRequestSourceRegexp.FindAllString(value, -1) {
+		if !integrationRequestSourceValidRegexp.MatchString(v) {
+			return fmt.Errorf("invalid request parameter mapping (%s) value (%s), expected $request.header.name, $request.querystring.name, $request.path[.name] or $request.body[.JSONPath]", key, v)
+		}
+	}
+
+	return nil
+}
+
+// validIntegrationResponseParameter validates an HTTP API integration response parameter mapping.
+func validIntegrationResponseParameter(statusCode, key, value string) error {
+	if !integrationResponseStatusCodeRegexp.MatchString(statusCode) {
+		return fmt.Errorf("invalid response parameters status code (%s), expected a value between 200 and 599", statusCode)
+	}
+
+	if !integrationResponseParameterKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid response parameter mapping key (%s) for status code (%s), expected <append|overwrite|remove>:header.name or overwrite:statuscode", key, statusCode)
+	}
+
+	for _, v := range integrationResponseSourceRegexp.FindAllString(value, -1) {
+		if !integrationResponseSourceValidRegexp.MatchString(v) {
+			return fmt.Errorf("invalid response parameter mapping (%s) value (%s) for status code (%s), expected $response.header.name or $response.body[.JSONPath]", key, v, statusCode)
+		}
+	}
+
+	return nil
+}
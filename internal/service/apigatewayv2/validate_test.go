@@ -0,0 +1,136 @@
+package apigatewayv2
+
+import (
+	"testing"
+)
+
+func TestValidIntegrationRequestParameter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Key         string
+		Value       string
+		ExpectError bool
+	}{
+		{
+			Key:         "append:header.header1",
+			Value:       "$context.requestId",
+			ExpectError: false,
+		},
+		{
+			Key:         "overwrite:querystring.qs1",
+			Value:       "$request.body.user.id",
+			ExpectError: false,
+		},
+		{
+			Key:         "overwrite:path",
+			Value:       "/users/$request.path.id",
+			ExpectError: false,
+		},
+		{
+			Key:         "remove:querystring.qs1",
+			Value:       "''",
+			ExpectError: false,
+		},
+		{
+			Key:         "append:header.header1",
+			Value:       "$request.header.x-user",
+			ExpectError: false,
+		},
+		{
+			Key:         "header.header1",
+			Value:       "$context.requestId",
+			ExpectError: true,
+		},
+		{
+			Key:         "replace:header.header1",
+			Value:       "$context.requestId",
+			ExpectError: true,
+		},
+		{
+			Key:         "overwrite:path.id",
+			Value:       "$context.requestId",
+			ExpectError: true,
+		},
+		{
+			Key:         "append:header.header1",
+			Value:       "$request.headers.x-user",
+			ExpectError: true,
+		},
+		{
+			Key:         "append:querystring.qs1",
+			Value:       "$request.querystring",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validIntegrationRequestParameter(tc.Key, tc.Value)
+
+		if got := err != nil; got != tc.ExpectError {
+			t.Errorf("validIntegrationRequestParameter(%q, %q) error = %v, expected error: %t", tc.Key, tc.Value, err, tc.ExpectError)
+		}
+	}
+}
+
+func TestValidIntegrationResponseParameter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		StatusCode  string
+		Key         string
+		Value       string
+		ExpectError bool
+	}{
+		{
+			StatusCode:  "500",
+			Key:         "overwrite:statuscode",
+			Value:       "403",
+			ExpectError: false,
+		},
+		{
+			StatusCode:  "200",
+			Key:         "append:header.header1",
+			Value:       "$response.header.x-request-id",
+			ExpectError: false,
+		},
+		{
+			StatusCode:  "200",
+			Key:         "overwrite:header.header1",
+			Value:       "$response.body.id",
+			ExpectError: false,
+		},
+		{
+			StatusCode:  "600",
+			Key:         "overwrite:statuscode",
+			Value:       "403",
+			ExpectError: true,
+		},
+		{
+			StatusCode:  "200",
+			Key:         "overwrite:querystring.qs1",
+			Value:       "value",
+			ExpectError: true,
+		},
+		{
+			StatusCode:  "200",
+			Key:         "append:statuscode",
+			Value:       "403",
+			ExpectError: true,
+		},
+		{
+			StatusCode:  "200",
+			Key:         "append:header.header1",
+			Value:       "$response.status",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validIntegrationResponseParameter(tc.StatusCode, tc.Key, tc.Value)
+
+		if got := err != nil; got != tc.ExpectError {
+			t.Errorf("validIntegrationResponseParameter(%q, %q, %q) error = %v, expected error: %t", tc.StatusCode, tc.Key, tc.Value, err, tc.ExpectError)
+		}
+	}
+}
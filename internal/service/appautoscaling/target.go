@@ -15,21 +15,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_appautoscaling_target")
 func ResourceTarget() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceTargetPut,
+		CreateWithoutTimeout: resourceTargetCreate,
 		ReadWithoutTimeout:   resourceTargetRead,
-		UpdateWithoutTimeout: resourceTargetPut,
+		UpdateWithoutTimeout: resourceTargetUpdate,
 		DeleteWithoutTimeout: resourceTargetDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTargetImport,
 		},
 
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"max_capacity": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -58,14 +66,79 @@ func ResourceTarget() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"suspended_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_scaling_in_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dynamic_scaling_out_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scheduled_scaling_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
-func resourceTargetPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	targetOpts := expandRegisterScalableTargetInput(d)
+
+	if len(tags) > 0 {
+		targetOpts.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if err := registerScalableTarget(ctx, conn, targetOpts); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Application AutoScaling Target: %s", err)
+	}
+
+	d.SetId(d.Get("resource_id").(string))
+
+	return append(diags, resourceTargetRead(ctx, d, meta)...)
+}
+
+func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
 
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := registerScalableTarget(ctx, conn, expandRegisterScalableTargetInput(d)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application AutoScaling Target (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application AutoScaling Target (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTargetRead(ctx, d, meta)...)
+}
+
+func expandRegisterScalableTargetInput(d *schema.ResourceData) *applicationautoscaling.RegisterScalableTargetInput {
 	var targetOpts applicationautoscaling.RegisterScalableTargetInput
 
 	targetOpts.MaxCapacity = aws.Int64(int64(d.Get("max_capacity").(int)))
@@ -78,10 +151,17 @@ func resourceTargetPut(ctx context.Context, d *schema.ResourceData, meta interfa
 		targetOpts.RoleARN = aws.String(roleArn.(string))
 	}
 
+	// Removing the block resumes all scaling activities.
+	targetOpts.SuspendedState = expandSuspendedState(d.Get("suspended_state").([]interface{}))
+
+	return &targetOpts
+}
+
+func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, targetOpts *applicationautoscaling.RegisterScalableTargetInput) error {
 	log.Printf("[DEBUG] Application autoscaling target create configuration %s", targetOpts)
 	var err error
 	err = resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
-		_, err = conn.RegisterScalableTargetWithContext(ctx, &targetOpts)
+		_, err = conn.RegisterScalableTargetWithContext(ctx, targetOpts)
 
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "Unable to assume IAM role") {
@@ -96,21 +176,17 @@ func resourceTargetPut(ctx context.Context, d *schema.ResourceData, meta interfa
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.RegisterScalableTargetWithContext(ctx, &targetOpts)
+		_, err = conn.RegisterScalableTargetWithContext(ctx, targetOpts)
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Application AutoScaling Target: %s", err)
-	}
-
-	d.SetId(d.Get("resource_id").(string))
-
-	return append(diags, resourceTargetRead(ctx, d, meta)...)
+	return err
 }
 
 func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, 2*time.Minute,
 		func() (interface{}, error) {
@@ -138,6 +214,36 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("scalable_dimension", t.ScalableDimension)
 	d.Set("service_namespace", t.ServiceNamespace)
 
+	// An unconfigured block is only tracked when scaling is suspended,
+	// so suspensions applied outside of Terraform are reported as drift.
+	if len(d.Get("suspended_state").([]interface{})) > 0 || isSuspended(t.SuspendedState) {
+		if err := d.Set("suspended_state", flattenSuspendedState(t.SuspendedState)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting suspended_state: %s", err)
+		}
+	} else {
+		d.Set("suspended_state", nil)
+	}
+
+	arn := aws.StringValue(t.ScalableTargetARN)
+	d.Set("arn", arn)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Application AutoScaling Target (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
 	return diags
 }
 
@@ -240,3 +346,51 @@ func resourceTargetImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func expandSuspendedState(tfList []interface{}) *applicationautoscaling.SuspendedState {
+	apiObject := &applicationautoscaling.SuspendedState{
+		DynamicScalingInSuspended:  aws.Bool(false),
+		DynamicScalingOutSuspended: aws.Bool(false),
+		ScheduledScalingSuspended:  aws.Bool(false),
+	}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["dynamic_scaling_in_suspended"].(bool); ok {
+		apiObject.DynamicScalingInSuspended = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["dynamic_scaling_out_suspended"].(bool); ok {
+		apiObject.DynamicScalingOutSuspended = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scheduled_scaling_suspended"].(bool); ok {
+		apiObject.ScheduledScalingSuspended = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenSuspendedState(apiObject *applicationautoscaling.SuspendedState) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dynamic_scaling_in_suspended":  aws.BoolValue(apiObject.DynamicScalingInSuspended),
+		"dynamic_scaling_out_suspended": aws.BoolValue(apiObject.DynamicScalingOutSuspended),
+		"scheduled_scaling_suspended":   aws.BoolValue(apiObject.ScheduledScalingSuspended),
+	}}
+}
+
+func isSuspended(apiObject *applicationautoscaling.SuspendedState) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	return aws.BoolValue(apiObject.DynamicScalingInSuspended) || aws.BoolValue(apiObject.DynamicScalingOutSuspended) || aws.BoolValue(apiObject.ScheduledScalingSuspended)
+}
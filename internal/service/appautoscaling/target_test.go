@@ -3,6 +3,7 @@ package appautoscaling_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -36,6 +37,9 @@ func TestAccAppAutoScalingTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "scalable_dimension", "ecs:service:DesiredCount"),
 					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "min_capacity", "1"),
 					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "max_capacity", "3"),
+					acctest.MatchResourceAttrRegionalARN("aws_appautoscaling_target.bar", "arn", "application-autoscaling", regexp.MustCompile(`scalable-target/.+`)),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "suspended_state.#", "0"),
+					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "tags.%", "0"),
 				),
 			},
 
@@ -81,6 +85,102 @@ func TestAccAppAutoScalingTarget_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingTarget_suspendedState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var target applicationautoscaling.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_suspendedState(rName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetConfig_suspendedState(rName, false, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", "false"),
+				),
+			},
+			{
+				Config: testAccTargetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppAutoScalingTarget_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var target applicationautoscaling.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTargetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppAutoScalingTarget_spotFleetRequest(t *testing.T) {
 	ctx := acctest.Context(t)
 	var target applicationautoscaling.ScalableTarget
@@ -294,6 +394,91 @@ resource "aws_appautoscaling_target" "bar" {
 `, randClusterName)
 }
 
+func testAccTargetConfig_ecsServiceBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<EOF
+[
+    {
+        "name": "busybox",
+        "image": "busybox:latest",
+        "cpu": 10,
+        "memory": 128,
+        "essential": true
+    }
+]
+EOF
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  deployment_maximum_percent         = 200
+  deployment_minimum_healthy_percent = 50
+}
+`, rName)
+}
+
+func testAccTargetConfig_suspendedState(rName string, dynamicScalingInSuspended, dynamicScalingOutSuspended, scheduledScalingSuspended bool) string {
+	return acctest.ConfigCompose(testAccTargetConfig_ecsServiceBase(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "ecs"
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 3
+
+  suspended_state {
+    dynamic_scaling_in_suspended  = %[1]t
+    dynamic_scaling_out_suspended = %[2]t
+    scheduled_scaling_suspended   = %[3]t
+  }
+}
+`, dynamicScalingInSuspended, dynamicScalingOutSuspended, scheduledScalingSuspended))
+}
+
+func testAccTargetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTargetConfig_ecsServiceBase(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "ecs"
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 3
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTargetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTargetConfig_ecsServiceBase(rName), fmt.Sprintf(`
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "ecs"
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 3
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
 func testAccTargetConfig_update(
 	randClusterName string) string {
 	return fmt.Sprintf(`
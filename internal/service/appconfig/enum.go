@@ -1,5 +1,9 @@
 package appconfig
 
+import (
+	"github.com/aws/aws-sdk-go/service/appconfig"
+)
+
 const (
 	configurationProfileTypeFeatureFlags = "AWS.AppConfig.FeatureFlags"
 	configurationProfileTypeFreeform     = "AWS.Freeform"
@@ -11,3 +15,12 @@ func ConfigurationProfileType_Values() []string {
 		configurationProfileTypeFreeform,
 	}
 }
+
+const (
+	// AT_DEPLOYMENT_TICK is not yet defined in the AWS SDK for Go.
+	actionPointAtDeploymentTick = "AT_DEPLOYMENT_TICK"
+)
+
+func actionPoint_Values() []string {
+	return append(appconfig.ActionPoint_Values(), actionPointAtDeploymentTick)
+}
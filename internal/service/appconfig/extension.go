@@ -47,7 +47,7 @@ func ResourceExtension() *schema.Resource {
 						"point": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice(appconfig.ActionPoint_Values(), false),
+							ValidateFunc: validation.StringInSlice(actionPoint_Values(), false),
 						},
 						"action": {
 							Type:     schema.TypeSet,
@@ -3,11 +3,15 @@ package appconfig
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -31,6 +35,8 @@ func ResourceExtensionAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceExtensionAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -52,8 +58,11 @@ func ResourceExtensionAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"extension_version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 		},
 	}
@@ -67,6 +76,10 @@ func resourceExtensionAssociationCreate(ctx context.Context, d *schema.ResourceD
 		ResourceIdentifier:  aws.String(d.Get("resource_arn").(string)),
 	}
 
+	if v, ok := d.GetOk("extension_version"); ok {
+		in.ExtensionVersionNumber = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		in.Parameters = flex.ExpandStringMap(v.(map[string]interface{}))
 	}
@@ -151,3 +164,54 @@ func resourceExtensionAssociationDelete(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// resourceExtensionAssociationCustomizeDiff verifies that a new association supplies all parameters the extension marks as required.
+// The check is skipped when the extension ARN or the parameter map is not known until apply, and for existing associations,
+// as the extension may be modified in the same apply.
+// When extension_version is not configured or not yet known, the latest version of the extension is checked.
+func resourceExtensionAssociationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("extension_arn") || !diff.NewValueKnown("parameters") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppConfigConn()
+	extensionARN := diff.Get("extension_arn").(string)
+
+	var version int
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("extension_version"); v.IsKnown() && !v.IsNull() {
+			version = diff.Get("extension_version").(int)
+		}
+	}
+
+	extension, err := findExtensionByIDAndVersion(ctx, conn, extensionARN, version)
+
+	if err != nil {
+		return fmt.Errorf("reading AppConfig Extension (%s): %w", extensionARN, err)
+	}
+
+	parameters := diff.Get("parameters").(map[string]interface{})
+	var missing []string
+
+	for name, parameter := range extension.Parameters {
+		if !aws.BoolValue(parameter.Required) {
+			continue
+		}
+
+		if _, ok := parameters[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return fmt.Errorf("AppConfig Extension (%s) requires parameters that are not set: %s", extensionARN, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
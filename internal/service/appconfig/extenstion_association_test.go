@@ -105,6 +105,48 @@ func TestAccAppConfigExtensionAssociation_Parameters(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigExtensionAssociation_requiredParameterMissing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_requiredParameterMissing(rName, false, false),
+			},
+			{
+				Config:      testAccExtensionAssociationConfig_requiredParameterMissing(rName, true, false),
+				ExpectError: regexp.MustCompile(`requires parameters that are not set: parameter1`),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigExtensionAssociation_requiredParameterMissingVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, appconfig.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_requiredParameterMissing(rName, false, true),
+			},
+			{
+				Config:      testAccExtensionAssociationConfig_requiredParameterMissing(rName, true, true),
+				ExpectError: regexp.MustCompile(`requires parameters that are not set: parameter1`),
+			},
+		},
+	})
+}
+
 func TestAccAppConfigExtensionAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -316,3 +358,47 @@ resource "aws_appconfig_extension_association" "test" {
 }
 `, rName, pName, pDescription, pRequired, pValue))
 }
+
+func testAccExtensionAssociationConfig_requiredParameterMissing(rName string, association, version bool) string {
+	config := acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name = %[1]q
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+  parameter {
+    name        = "parameter1"
+    description = "description1"
+    required    = true
+  }
+}
+`, rName))
+
+	if !association {
+		return config
+	}
+
+	if version {
+		return acctest.ConfigCompose(config, `
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn     = aws_appconfig_extension.test.arn
+  extension_version = aws_appconfig_extension.test.version
+  resource_arn      = aws_appconfig_application.test.arn
+}
+`)
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn = aws_appconfig_extension.test.arn
+  resource_arn  = aws_appconfig_application.test.arn
+}
+`)
+}
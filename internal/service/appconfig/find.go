@@ -11,7 +11,17 @@ import (
 )
 
 func FindExtensionById(ctx context.Context, conn *appconfig.AppConfig, id string) (*appconfig.GetExtensionOutput, error) {
+	return findExtensionByIDAndVersion(ctx, conn, id, 0)
+}
+
+// findExtensionByIDAndVersion returns the specified version of an extension, or the latest version if version is 0.
+func findExtensionByIDAndVersion(ctx context.Context, conn *appconfig.AppConfig, id string, version int) (*appconfig.GetExtensionOutput, error) {
 	in := &appconfig.GetExtensionInput{ExtensionIdentifier: aws.String(id)}
+
+	if version > 0 {
+		in.VersionNumber = aws.Int64(int64(version))
+	}
+
 	out, err := conn.GetExtensionWithContext(ctx, in)
 
 	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
@@ -0,0 +1,94 @@
+package applicationinsights
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_applicationinsights_components")
+func DataSourceComponents() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComponentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"components": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"component_remarks": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"os_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsConn()
+
+	name := d.Get("resource_group_name").(string)
+	components, err := FindComponentsByApplicationName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ApplicationInsights Application (%s) Components: %s", name, err)
+	}
+
+	d.SetId(name)
+	if err := d.Set("components", flattenApplicationComponents(components)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting components: %s", err)
+	}
+
+	return diags
+}
+
+func flattenApplicationComponents(apiObjects []*applicationinsights.ApplicationComponent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"component_name":    aws.StringValue(apiObject.ComponentName),
+			"component_remarks": aws.StringValue(apiObject.ComponentRemarks),
+			"monitor":           aws.BoolValue(apiObject.Monitor),
+			"os_type":           aws.StringValue(apiObject.OsType),
+			"resource_type":     aws.StringValue(apiObject.ResourceType),
+			"tier":              aws.StringValue(apiObject.Tier),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,41 @@
+package applicationinsights_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccApplicationInsightsComponentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_applicationinsights_components.test"
+	resourceName := "aws_applicationinsights_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_group_name", resourceName, "resource_group_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "components.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_updated(rName), `
+data "aws_applicationinsights_components" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+}
+`)
+}
@@ -34,3 +34,37 @@ func FindApplicationByName(ctx context.Context, conn *applicationinsights.Applic
 
 	return output.ApplicationInfo, nil
 }
+
+func FindComponentsByApplicationName(ctx context.Context, conn *applicationinsights.ApplicationInsights, name string) ([]*applicationinsights.ApplicationComponent, error) {
+	input := &applicationinsights.ListComponentsInput{
+		ResourceGroupName: aws.String(name),
+	}
+	var output []*applicationinsights.ApplicationComponent
+
+	err := conn.ListComponentsPagesWithContext(ctx, input, func(page *applicationinsights.ListComponentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ApplicationComponentList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationinsights.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
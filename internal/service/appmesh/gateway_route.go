@@ -11,7 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -203,9 +205,31 @@ func ResourceGatewayRoute() *schema.Resource {
 																	},
 																},
 																AtLeastOneOf: []string{
+																	"spec.0.http2_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http2_route.0.action.0.rewrite.0.path",
 																	"spec.0.http2_route.0.action.0.rewrite.0.prefix",
+																},
+															},
+															"path": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 1,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"exact": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+																		},
+																	},
+																},
+																AtLeastOneOf: []string{
 																	"spec.0.http2_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http2_route.0.action.0.rewrite.0.path",
+																	"spec.0.http2_route.0.action.0.rewrite.0.prefix",
 																},
+																ConflictsWith: []string{"spec.0.http2_route.0.action.0.rewrite.0.prefix"},
 															},
 															"prefix": {
 																Type:     schema.TypeList,
@@ -235,8 +259,9 @@ func ResourceGatewayRoute() *schema.Resource {
 																	},
 																},
 																AtLeastOneOf: []string{
-																	"spec.0.http2_route.0.action.0.rewrite.0.prefix",
 																	"spec.0.http2_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http2_route.0.action.0.rewrite.0.path",
+																	"spec.0.http2_route.0.action.0.rewrite.0.prefix",
 																},
 															},
 														},
@@ -258,8 +283,9 @@ func ResourceGatewayRoute() *schema.Resource {
 													Optional:     true,
 													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
 													AtLeastOneOf: []string{
-														"spec.0.http2_route.0.match.0.prefix",
 														"spec.0.http2_route.0.match.0.hostname",
+														"spec.0.http2_route.0.match.0.path",
+														"spec.0.http2_route.0.match.0.prefix",
 													},
 												},
 												"hostname": {
@@ -288,9 +314,44 @@ func ResourceGatewayRoute() *schema.Resource {
 														},
 													},
 													AtLeastOneOf: []string{
+														"spec.0.http2_route.0.match.0.hostname",
+														"spec.0.http2_route.0.match.0.path",
 														"spec.0.http2_route.0.match.0.prefix",
+													},
+												},
+												"path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"exact": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+																ExactlyOneOf: []string{
+																	"spec.0.http2_route.0.match.0.path.0.exact",
+																	"spec.0.http2_route.0.match.0.path.0.regex",
+																},
+															},
+															"regex": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+																ExactlyOneOf: []string{
+																	"spec.0.http2_route.0.match.0.path.0.exact",
+																	"spec.0.http2_route.0.match.0.path.0.regex",
+																},
+															},
+														},
+													},
+													AtLeastOneOf: []string{
 														"spec.0.http2_route.0.match.0.hostname",
+														"spec.0.http2_route.0.match.0.path",
+														"spec.0.http2_route.0.match.0.prefix",
 													},
+													ConflictsWith: []string{"spec.0.http2_route.0.match.0.prefix"},
 												},
 												"port": {
 													Type:         schema.TypeInt,
@@ -370,9 +431,31 @@ func ResourceGatewayRoute() *schema.Resource {
 																	},
 																},
 																AtLeastOneOf: []string{
+																	"spec.0.http_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http_route.0.action.0.rewrite.0.path",
 																	"spec.0.http_route.0.action.0.rewrite.0.prefix",
+																},
+															},
+															"path": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 1,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"exact": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+																		},
+																	},
+																},
+																AtLeastOneOf: []string{
 																	"spec.0.http_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http_route.0.action.0.rewrite.0.path",
+																	"spec.0.http_route.0.action.0.rewrite.0.prefix",
 																},
+																ConflictsWith: []string{"spec.0.http_route.0.action.0.rewrite.0.prefix"},
 															},
 															"prefix": {
 																Type:     schema.TypeList,
@@ -402,8 +485,9 @@ func ResourceGatewayRoute() *schema.Resource {
 																	},
 																},
 																AtLeastOneOf: []string{
-																	"spec.0.http_route.0.action.0.rewrite.0.prefix",
 																	"spec.0.http_route.0.action.0.rewrite.0.hostname",
+																	"spec.0.http_route.0.action.0.rewrite.0.path",
+																	"spec.0.http_route.0.action.0.rewrite.0.prefix",
 																},
 															},
 														},
@@ -424,8 +508,9 @@ func ResourceGatewayRoute() *schema.Resource {
 													Optional:     true,
 													ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
 													AtLeastOneOf: []string{
-														"spec.0.http_route.0.match.0.prefix",
 														"spec.0.http_route.0.match.0.hostname",
+														"spec.0.http_route.0.match.0.path",
+														"spec.0.http_route.0.match.0.prefix",
 													},
 												},
 												"hostname": {
@@ -454,9 +539,44 @@ func ResourceGatewayRoute() *schema.Resource {
 														},
 													},
 													AtLeastOneOf: []string{
+														"spec.0.http_route.0.match.0.hostname",
+														"spec.0.http_route.0.match.0.path",
 														"spec.0.http_route.0.match.0.prefix",
+													},
+												},
+												"path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"exact": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+																ExactlyOneOf: []string{
+																	"spec.0.http_route.0.match.0.path.0.exact",
+																	"spec.0.http_route.0.match.0.path.0.regex",
+																},
+															},
+															"regex": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+																ExactlyOneOf: []string{
+																	"spec.0.http_route.0.match.0.path.0.exact",
+																	"spec.0.http_route.0.match.0.path.0.regex",
+																},
+															},
+														},
+													},
+													AtLeastOneOf: []string{
 														"spec.0.http_route.0.match.0.hostname",
+														"spec.0.http_route.0.match.0.path",
+														"spec.0.http_route.0.match.0.prefix",
 													},
+													ConflictsWith: []string{"spec.0.http_route.0.match.0.prefix"},
 												},
 												"port": {
 													Type:         schema.TypeInt,
@@ -474,6 +594,12 @@ func ResourceGatewayRoute() *schema.Resource {
 								"spec.0.http_route",
 							},
 						},
+
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
 					},
 				},
 			},
@@ -503,10 +629,34 @@ func ResourceGatewayRoute() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceGatewayRouteCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceGatewayRouteCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A path rewrite can only be used with a path match and a prefix rewrite only with a prefix match.
+	for _, routeType := range []string{"http2_route", "http_route"} {
+		key := fmt.Sprintf("spec.0.%s.0", routeType)
+
+		if v := diff.Get(key + ".action.0.rewrite.0.path").([]interface{}); len(v) > 0 {
+			if v := diff.Get(key + ".match.0.path").([]interface{}); len(v) == 0 {
+				return fmt.Errorf("%s: a path rewrite requires a path match", routeType)
+			}
+		}
+
+		if v := diff.Get(key + ".action.0.rewrite.0.prefix").([]interface{}); len(v) > 0 {
+			if v := diff.Get(key + ".match.0.prefix").(string); v == "" {
+				return fmt.Errorf("%s: a prefix rewrite requires a prefix match", routeType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceGatewayRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppMeshConn()
@@ -524,6 +674,8 @@ func resourceGatewayRouteCreate(ctx context.Context, d *schema.ResourceData, met
 		input.MeshOwner = aws.String(v.(string))
 	}
 
+	expandGatewayRoutePriority(d, input.Spec)
+
 	log.Printf("[DEBUG] Creating App Mesh gateway route: %s", input)
 	output, err := conn.CreateGatewayRouteWithContext(ctx, input)
 
@@ -643,6 +795,8 @@ func resourceGatewayRouteUpdate(ctx context.Context, d *schema.ResourceData, met
 			input.MeshOwner = aws.String(v.(string))
 		}
 
+		expandGatewayRoutePriority(d, input.Spec)
+
 		log.Printf("[DEBUG] Updating App Mesh gateway route: %s", input)
 		_, err := conn.UpdateGatewayRouteWithContext(ctx, input)
 
@@ -742,6 +896,31 @@ func expandGatewayRouteSpec(vSpec []interface{}) *appmesh.GatewayRouteSpec {
 	return spec
 }
 
+// expandGatewayRoutePriority sets the spec's priority when it is configured, as 0 is the highest priority.
+func expandGatewayRoutePriority(d *schema.ResourceData, spec *appmesh.GatewayRouteSpec) {
+	if spec == nil {
+		return
+	}
+
+	rawConfig := d.GetRawConfig()
+
+	if rawConfig.IsNull() {
+		return
+	}
+
+	rawSpec := rawConfig.GetAttr("spec")
+
+	if !rawSpec.IsKnown() || rawSpec.IsNull() || rawSpec.LengthInt() == 0 {
+		return
+	}
+
+	if rawSpec.Index(cty.NumberIntVal(0)).GetAttr("priority").IsNull() {
+		return
+	}
+
+	spec.Priority = aws.Int64(int64(d.Get("spec.0.priority").(int)))
+}
+
 func expandGatewayRouteTarget(vRouteTarget []interface{}) *appmesh.GatewayRouteTarget {
 	if len(vRouteTarget) == 0 || vRouteTarget[0] == nil {
 		return nil
@@ -822,6 +1001,15 @@ func expandHTTPGatewayRouteRewrite(vHttpRouteRewrite []interface{}) *appmesh.Htt
 		routeRewrite.Hostname = routeHostnameRewrite
 	}
 
+	if vRoutePathRewrite, ok := mRouteRewrite["path"].([]interface{}); ok && len(vRoutePathRewrite) > 0 && vRoutePathRewrite[0] != nil {
+		mRoutePathRewrite := vRoutePathRewrite[0].(map[string]interface{})
+		routePathRewrite := &appmesh.HttpGatewayRoutePathRewrite{}
+		if vExact, ok := mRoutePathRewrite["exact"].(string); ok && vExact != "" {
+			routePathRewrite.Exact = aws.String(vExact)
+		}
+		routeRewrite.Path = routePathRewrite
+	}
+
 	if vRoutePrefixRewrite, ok := mRouteRewrite["prefix"].([]interface{}); ok && len(vRoutePrefixRewrite) > 0 && vRoutePrefixRewrite[0] != nil {
 		mRoutePrefixRewrite := vRoutePrefixRewrite[0].(map[string]interface{})
 		routePrefixRewrite := &appmesh.HttpGatewayRoutePrefixRewrite{}
@@ -864,6 +1052,20 @@ func expandHTTPGatewayRouteMatch(vHttpRouteMatch []interface{}) *appmesh.HttpGat
 		routeMatch.Hostname = hostnameMatch
 	}
 
+	if vPathMatch, ok := mRouteMatch["path"].([]interface{}); ok && len(vPathMatch) > 0 && vPathMatch[0] != nil {
+		pathMatch := &appmesh.HttpPathMatch{}
+
+		mPathMatch := vPathMatch[0].(map[string]interface{})
+		if vExact, ok := mPathMatch["exact"].(string); ok && vExact != "" {
+			pathMatch.Exact = aws.String(vExact)
+		}
+		if vRegex, ok := mPathMatch["regex"].(string); ok && vRegex != "" {
+			pathMatch.Regex = aws.String(vRegex)
+		}
+
+		routeMatch.Path = pathMatch
+	}
+
 	if vPort, ok := mRouteMatch["port"].(int); ok && vPort > 0 {
 		routeMatch.Port = aws.Int64(int64(vPort))
 	}
@@ -912,6 +1114,7 @@ func flattenGatewayRouteSpec(spec *appmesh.GatewayRouteSpec) []interface{} {
 		"grpc_route":  flattenGRPCGatewayRoute(spec.GrpcRoute),
 		"http2_route": flattenHTTPGatewayRoute(spec.Http2Route),
 		"http_route":  flattenHTTPGatewayRoute(spec.HttpRoute),
+		"priority":    int(aws.Int64Value(spec.Priority)),
 	}
 
 	return []interface{}{mSpec}
@@ -987,6 +1190,18 @@ func flattenHTTPGatewayRouteMatch(routeMatch *appmesh.HttpGatewayRouteMatch) []i
 		mRouteMatch["hostname"] = []interface{}{mHostnameMatch}
 	}
 
+	if pathMatch := routeMatch.Path; pathMatch != nil {
+		mPathMatch := map[string]interface{}{}
+		if pathMatch.Exact != nil {
+			mPathMatch["exact"] = aws.StringValue(pathMatch.Exact)
+		}
+		if pathMatch.Regex != nil {
+			mPathMatch["regex"] = aws.StringValue(pathMatch.Regex)
+		}
+
+		mRouteMatch["path"] = []interface{}{mPathMatch}
+	}
+
 	if routeMatch.Port != nil {
 		mRouteMatch["port"] = int(aws.Int64Value(routeMatch.Port))
 	}
@@ -1008,6 +1223,13 @@ func flattenHTTPGatewayRouteRewrite(routeRewrite *appmesh.HttpGatewayRouteRewrit
 		mRouteRewrite["hostname"] = []interface{}{mRewriteHostname}
 	}
 
+	if rewritePath := routeRewrite.Path; rewritePath != nil {
+		mRewritePath := map[string]interface{}{
+			"exact": aws.StringValue(rewritePath.Exact),
+		}
+		mRouteRewrite["path"] = []interface{}{mRewritePath}
+	}
+
 	if rewritePrefix := routeRewrite.Prefix; rewritePrefix != nil {
 		mRewritePrefix := map[string]interface{}{
 			"default_prefix": aws.StringValue(rewritePrefix.DefaultPrefix),
@@ -3,8 +3,10 @@ package appmesh_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -368,6 +370,91 @@ func testAccGatewayRoute_HTTPRoute(t *testing.T) {
 	})
 }
 
+func testAccGatewayRoute_HTTPRoutePathRewrite(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appmesh.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appmesh.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGatewayRouteConfig_httpRoutePathRewriteInvalid(meshName, vgName, grName),
+				ExpectError: regexp.MustCompile(`a path rewrite requires a path match`),
+			},
+			{
+				Config: testAccGatewayRouteConfig_httpRoutePathRewrite(meshName, vgName, grName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayRouteExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.path.0.exact", "/new"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.action.0.rewrite.0.prefix.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.path.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.path.0.exact", "/old"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.0.match.0.prefix", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGatewayRoute_priority(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appmesh.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appmesh.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayRouteConfig_priority(meshName, vgName, grName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayRouteExists(ctx, resourceName, &v),
+					testAccCheckGatewayRoutePriority(&v, 10),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority", "10"),
+				),
+			},
+			{
+				Config: testAccGatewayRouteConfig_priority(meshName, vgName, grName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayRouteExists(ctx, resourceName, &v),
+					testAccCheckGatewayRoutePriority(&v, 0),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccGatewayRoute_HTTPRouteWithPort(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v appmesh.GatewayRouteData
@@ -863,6 +950,20 @@ func testAccCheckGatewayRouteExists(ctx context.Context, name string, v *appmesh
 	}
 }
 
+func testAccCheckGatewayRoutePriority(v *appmesh.GatewayRouteData, want int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.Spec == nil || v.Spec.Priority == nil {
+			return fmt.Errorf("App Mesh gateway route priority is not set, want %d", want)
+		}
+
+		if got := aws.Int64Value(v.Spec.Priority); got != want {
+			return fmt.Errorf("App Mesh gateway route priority = %d, want %d", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccGatewayRouteConfigBase(meshName, vgName, protocol string) string {
 	return fmt.Sprintf(`
 resource "aws_appmesh_mesh" "test" {
@@ -1168,6 +1269,100 @@ resource "aws_appmesh_gateway_route" "test" {
 `, grName))
 }
 
+func testAccGatewayRouteConfig_httpRoutePathRewrite(meshName, vgName, grName string) string {
+	return acctest.ConfigCompose(testAccGatewayRouteConfigBase(meshName, vgName, "http"), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    priority = 10
+
+    http_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+        rewrite {
+          path {
+            exact = "/new"
+          }
+        }
+      }
+
+      match {
+        path {
+          exact = "/old"
+        }
+      }
+    }
+  }
+}
+`, grName))
+}
+
+func testAccGatewayRouteConfig_priority(meshName, vgName, grName string, priority int) string {
+	return acctest.ConfigCompose(testAccGatewayRouteConfigBase(meshName, vgName, "http"), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    priority = %[2]d
+
+    http_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+      }
+
+      match {
+        prefix = "/"
+      }
+    }
+  }
+}
+`, grName, priority))
+}
+
+func testAccGatewayRouteConfig_httpRoutePathRewriteInvalid(meshName, vgName, grName string) string {
+	return acctest.ConfigCompose(testAccGatewayRouteConfigBase(meshName, vgName, "http"), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    http_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+        rewrite {
+          path {
+            exact = "/new"
+          }
+        }
+      }
+
+      match {
+        prefix = "/old"
+      }
+    }
+  }
+}
+`, grName))
+}
+
 func testAccGatewayRouteConfig_http2Route(meshName, vgName, grName string) string {
 	return acctest.ConfigCompose(testAccGatewayRouteConfigBase(meshName, vgName, "http2"), fmt.Sprintf(`
 resource "aws_appmesh_gateway_route" "test" {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -69,6 +70,10 @@ func ResourceStack() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+						"s3_bucket_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"settings_group": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -102,8 +107,11 @@ func ResourceStack() *schema.Resource {
 				MinItems: 1,
 				MaxItems: 20,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringLenBetween(0, 128),
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(0, 128),
+						validation.StringMatch(regexp.MustCompile(`^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$`), "must be a lowercase domain name without a scheme or path, e.g. example.com"),
+					),
 				},
 				Set: schema.HashString,
 			},
@@ -155,6 +163,22 @@ func ResourceStack() *schema.Resource {
 				},
 				Set: storageConnectorsHash,
 			},
+			"streaming_experience_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preferred_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(appstream.PreferredProtocol_Values(), false),
+						},
+					},
+				},
+			},
 			"user_settings": {
 				Type:             schema.TypeSet,
 				Optional:         true,
@@ -271,6 +295,10 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.StorageConnectors = expandStorageConnectors(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("streaming_experience_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.StreamingExperienceSettings = expandStreamingExperienceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("user_settings"); ok {
 		input.UserSettings = expandUserSettings(v.(*schema.Set).List())
 	}
@@ -343,6 +371,13 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		if err = d.Set("storage_connectors", flattenStorageConnectors(v.StorageConnectors)); err != nil {
 			return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "storage_connectors", d.Id(), err))
 		}
+		if v.StreamingExperienceSettings != nil {
+			if err = d.Set("streaming_experience_settings", []interface{}{flattenStreamingExperienceSettings(v.StreamingExperienceSettings)}); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "streaming_experience_settings", d.Id(), err))
+			}
+		} else {
+			d.Set("streaming_experience_settings", nil)
+		}
 		if err = d.Set("user_settings", flattenUserSettings(v.UserSettings)); err != nil {
 			return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "user_settings", d.Id(), err))
 		}
@@ -398,6 +433,12 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.RedirectURL = aws.String(d.Get("redirect_url").(string))
 	}
 
+	if d.HasChange("streaming_experience_settings") {
+		if v, ok := d.GetOk("streaming_experience_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.StreamingExperienceSettings = expandStreamingExperienceSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("user_settings") {
 		input.UserSettings = expandUserSettings(d.Get("user_settings").(*schema.Set).List())
 	}
@@ -405,7 +446,7 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	resp, err := conn.UpdateStackWithContext(ctx, input)
 
 	if err != nil {
-		diag.FromErr(fmt.Errorf("error updating Appstream Stack (%s): %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error updating Appstream Stack (%s): %w", d.Id(), err))
 	}
 
 	if d.HasChange("tags") {
@@ -544,6 +585,7 @@ func flattenApplicationSetting(apiObject *appstream.ApplicationSettingsResponse)
 
 	return map[string]interface{}{
 		"enabled":        aws.BoolValue(apiObject.Enabled),
+		"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
 		"settings_group": aws.StringValue(apiObject.SettingsGroup),
 	}
 }
@@ -560,6 +602,30 @@ func flattenApplicationSettings(apiObject *appstream.ApplicationSettingsResponse
 	return tfList
 }
 
+func expandStreamingExperienceSettings(tfMap map[string]interface{}) *appstream.StreamingExperienceSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &appstream.StreamingExperienceSettings{}
+
+	if v, ok := tfMap["preferred_protocol"].(string); ok && v != "" {
+		apiObject.PreferredProtocol = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenStreamingExperienceSettings(apiObject *appstream.StreamingExperienceSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"preferred_protocol": aws.StringValue(apiObject.PreferredProtocol),
+	}
+}
+
 func expandStorageConnector(tfMap map[string]interface{}) *appstream.StorageConnector {
 	if tfMap == nil {
 		return nil
@@ -703,20 +769,25 @@ func flattenUserSettings(apiObjects []*appstream.UserSetting) []map[string]inter
 	return tfList
 }
 
+// suppressAppsStreamStackUserSettings suppresses user settings differences when every configured
+// setting is already in place. AppStream returns a setting for every action, including actions
+// added after the configuration was written, and actions that aren't configured keep their current
+// permission. Any change to a configured setting is still shown in full.
 func suppressAppsStreamStackUserSettings(k, old, new string, d *schema.ResourceData) bool {
-	flagDiffUserSettings := false
-	count := len(d.Get("user_settings").(*schema.Set).List())
-	defaultCount := len(appstream.Action_Values())
+	o, n := d.GetChange("user_settings")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
 
-	if count == defaultCount {
-		flagDiffUserSettings = false
+	if os.Len() == 0 || ns.Len() == 0 {
+		return false
 	}
 
-	if count != defaultCount && (fmt.Sprintf("%d", count) == new && fmt.Sprintf("%d", defaultCount) == old) {
-		flagDiffUserSettings = true
+	for _, v := range ns.List() {
+		if !os.Contains(v) {
+			return false
+		}
 	}
 
-	return flagDiffUserSettings
+	return true
 }
 
 func accessEndpointsHash(v interface{}) int {
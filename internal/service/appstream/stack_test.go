@@ -3,6 +3,7 @@ package appstream_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -161,6 +162,7 @@ func TestAccAppStreamStack_applicationSettings_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "application_settings.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "application_settings.0.enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "application_settings.0.settings_group", settingsGroup),
+					resource.TestCheckResourceAttrSet(resourceName, "application_settings.0.s3_bucket_name"),
 				),
 			},
 			{
@@ -269,6 +271,111 @@ func TestAccAppStreamStack_applicationSettings_removeFromDisabled(t *testing.T)
 	})
 }
 
+func TestAccAppStreamStack_embedHostDomainsInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStackConfig_embedHostDomains(rName, "https://example.com"),
+				ExpectError: regexp.MustCompile(`must be a lowercase domain name without a scheme or path`),
+			},
+			{
+				Config:      testAccStackConfig_embedHostDomains(rName, "Example.com"),
+				ExpectError: regexp.MustCompile(`must be a lowercase domain name without a scheme or path`),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamStack_streamingExperienceSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackOutput appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_streamingExperienceSettings(rName, appstream.PreferredProtocolTcp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.0.preferred_protocol", appstream.PreferredProtocolTcp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStackConfig_streamingExperienceSettings(rName, appstream.PreferredProtocolUdp),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.0.preferred_protocol", appstream.PreferredProtocolUdp),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamStack_userSettingsPartial(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackOutput appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			// Actions that aren't configured are returned by AppStream and must not cause a diff.
+			{
+				Config: testAccStackConfig_userSettingsPartial(rName, appstream.PermissionDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": appstream.PermissionDisabled,
+					}),
+				),
+			},
+			{
+				Config:   testAccStackConfig_userSettingsPartial(rName, appstream.PermissionDisabled),
+				PlanOnly: true,
+			},
+			// Changing the permission of a configured action must not be suppressed.
+			{
+				Config: testAccStackConfig_userSettingsPartial(rName, appstream.PermissionEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user_settings.*", map[string]string{
+						"action":     appstream.ActionClipboardCopyFromLocalDevice,
+						"permission": appstream.PermissionEnabled,
+					}),
+				),
+			},
+			{
+				Config:   testAccStackConfig_userSettingsPartial(rName, appstream.PermissionEnabled),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAppStreamStack_withTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackOutput appstream.Stack
@@ -506,3 +613,37 @@ resource "aws_appstream_stack" "test" {
 }
 `, name, description)
 }
+
+func testAccStackConfig_embedHostDomains(name, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name               = %[1]q
+  embed_host_domains = [%[2]q]
+}
+`, name, domain)
+}
+
+func testAccStackConfig_streamingExperienceSettings(name, preferredProtocol string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  streaming_experience_settings {
+    preferred_protocol = %[2]q
+  }
+}
+`, name, preferredProtocol)
+}
+
+func testAccStackConfig_userSettingsPartial(name, permission string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  user_settings {
+    action     = "CLIPBOARD_COPY_FROM_LOCAL_DEVICE"
+    permission = %[2]q
+  }
+}
+`, name, permission)
+}
@@ -75,6 +75,7 @@ func TestAccAppSync_serial(t *testing.T) {
 		"Resolver": {
 			"basic":             testAccResolver_basic,
 			"code":              testAccResolver_code,
+			"codeError":         testAccResolver_codeError,
 			"disappears":        testAccResolver_disappears,
 			"dataSource":        testAccResolver_dataSource,
 			"DataSource_lambda": testAccResolver_DataSource_lambda,
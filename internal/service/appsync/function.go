@@ -30,6 +30,8 @@ func ResourceFunction() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffEvaluateCode,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -54,6 +56,11 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"evaluate_code": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"function_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -228,6 +235,11 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("response_mapping_template", function.ResponseMappingTemplate)
 	d.Set("max_batch_size", function.MaxBatchSize)
 	d.Set("code", function.Code)
+	if v, ok := d.GetOkExists("evaluate_code"); ok {
+		d.Set("evaluate_code", v)
+	} else {
+		d.Set("evaluate_code", true)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(function.SyncConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sync_config: %s", err)
@@ -273,7 +285,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ResponseMappingTemplate = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("max_batch_size"); ok {
+	if v, ok := d.GetOkExists("max_batch_size"); ok {
 		input.MaxBatchSize = aws.Int64(int64(v.(int)))
 	}
 
@@ -326,6 +338,54 @@ func DecodeFunctionID(id string) (string, string, error) {
 	return idParts[0], idParts[1], nil
 }
 
+// customizeDiffEvaluateCode checks, on a best-effort basis, APPSYNC_JS code for errors at plan time.
+// Only errors found in the code itself are reported; API errors are logged and ignored.
+func customizeDiffEvaluateCode(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("evaluate_code").(bool) || !diff.HasChange("code") || !diff.NewValueKnown("code") || !diff.NewValueKnown("runtime") {
+		return nil
+	}
+
+	code := diff.Get("code").(string)
+	runtime := expandRuntime(diff.Get("runtime").([]interface{}))
+
+	if code == "" || runtime == nil || aws.StringValue(runtime.Name) != appsync.RuntimeNameAppsyncJs {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).AppSyncConn()
+
+	output, err := conn.EvaluateCodeWithContext(ctx, &appsync.EvaluateCodeInput{
+		Code:    aws.String(code),
+		Context: aws.String("{}"),
+		Runtime: runtime,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Unable to evaluate AppSync code: %s", err)
+		return nil
+	}
+
+	if output.Error == nil || len(output.Error.CodeErrors) == 0 {
+		return nil
+	}
+
+	var errs []string
+
+	for _, v := range output.Error.CodeErrors {
+		if v == nil {
+			continue
+		}
+
+		if l := v.Location; l != nil {
+			errs = append(errs, fmt.Sprintf("line %d, column %d: %s: %s", aws.Int64Value(l.Line), aws.Int64Value(l.Column), aws.StringValue(v.ErrorType), aws.StringValue(v.Value)))
+		} else {
+			errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.ErrorType), aws.StringValue(v.Value)))
+		}
+	}
+
+	return fmt.Errorf("evaluating %s %s code: %s", aws.StringValue(runtime.Name), aws.StringValue(runtime.RuntimeVersion), strings.Join(errs, "; "))
+}
+
 func expandRuntime(l []interface{}) *appsync.AppSyncRuntime {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -32,6 +32,8 @@ func ResourceResolver() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffEvaluateCode,
+
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -74,6 +76,11 @@ func ResourceResolver() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"pipeline_config"},
 			},
+			"evaluate_code": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"field": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -279,6 +286,11 @@ func resourceResolverRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("kind", resolver.Kind)
 	d.Set("max_batch_size", resolver.MaxBatchSize)
 	d.Set("code", resolver.Code)
+	if v, ok := d.GetOkExists("evaluate_code"); ok {
+		d.Set("evaluate_code", v)
+	} else {
+		d.Set("evaluate_code", true)
+	}
 
 	if err := d.Set("sync_config", flattenSyncConfig(resolver.SyncConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sync_config: %s", err)
@@ -318,11 +330,8 @@ func resourceResolverUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.DataSourceName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("pipeline_config"); ok {
-		config := v.([]interface{})[0].(map[string]interface{})
-		input.PipelineConfig = &appsync.PipelineConfig{
-			Functions: flex.ExpandStringList(config["functions"].([]interface{})),
-		}
+	if v, ok := d.GetOk("pipeline_config"); ok && len(v.([]interface{})) > 0 {
+		input.PipelineConfig = expandPipelineConfig(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("request_template"); ok {
@@ -428,6 +437,7 @@ func expandPipelineConfig(l []interface{}) *appsync.PipelineConfig {
 
 	m := l[0].(map[string]interface{})
 
+	// Pipeline functions run in the order they are listed.
 	config := &appsync.PipelineConfig{}
 
 	if v, ok := m["functions"].([]interface{}); ok && len(v) > 0 {
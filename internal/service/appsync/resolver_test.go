@@ -80,6 +80,24 @@ func testAccResolver_code(t *testing.T) {
 	})
 }
 
+func testAccResolver_codeError(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, appsync.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, appsync.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResolverDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResolverConfig_code(rName, "test-fixtures/test-code-invalid.js"),
+				ExpectError: regexp.MustCompile(`evaluating APPSYNC_JS 1.0.0 code`),
+			},
+		},
+	})
+}
+
 func testAccResolver_syncConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var resolver1 appsync.Resolver
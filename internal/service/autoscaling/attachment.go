@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -108,11 +109,29 @@ func resourceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
-	var err error
+	// Re-resolve the group first so that an attachment whose group has been
+	// deleted (e.g. replaced following a name change) is treated as gone.
+	asg, err := FindGroupByName(ctx, conn, asgName)
+
+	if err == nil && aws.StringValue(asg.Status) == groupStatusDeleteInProgress {
+		err = &resource.NotFoundError{
+			Message: fmt.Sprintf("Auto Scaling Group (%s) is being deleted", asgName),
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Auto Scaling Group (%s) not found, removing Auto Scaling Group Attachment %s from state", asgName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group Attachment (%s): %s", d.Id(), err)
+	}
 
 	if v, ok := d.GetOk("elb"); ok {
 		lbName := v.(string)
-		err = FindAttachmentByLoadBalancerName(ctx, conn, asgName, lbName)
+		err = checkAttachmentByLoadBalancerName(asg, lbName)
 	} else {
 		var targetGroupARN string
 		if v, ok := d.GetOk("alb_target_group_arn"); ok {
@@ -120,7 +139,7 @@ func resourceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta in
 		} else if v, ok := d.GetOk("lb_target_group_arn"); ok {
 			targetGroupARN = v.(string)
 		}
-		err = FindAttachmentByTargetGroupARN(ctx, conn, asgName, targetGroupARN)
+		err = checkAttachmentByTargetGroupARN(asg, targetGroupARN)
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -154,6 +173,10 @@ func resourceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta
 			},
 			ErrCodeValidationError, "update too many")
 
+		if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "not found") {
+			return diags
+		}
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "detaching Auto Scaling Group (%s) load balancer (%s): %s", asgName, lbName, err)
 		}
@@ -176,6 +199,10 @@ func resourceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta
 			},
 			ErrCodeValidationError, "update too many")
 
+		if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "not found") {
+			return diags
+		}
+
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "detaching Auto Scaling Group (%s) target group (%s): %s", asgName, targetGroupARN, err)
 		}
@@ -191,6 +218,10 @@ func FindAttachmentByLoadBalancerName(ctx context.Context, conn *autoscaling.Aut
 		return err
 	}
 
+	return checkAttachmentByLoadBalancerName(asg, loadBalancerName)
+}
+
+func checkAttachmentByLoadBalancerName(asg *autoscaling.Group, loadBalancerName string) error {
 	for _, v := range asg.LoadBalancerNames {
 		if aws.StringValue(v) == loadBalancerName {
 			return nil
@@ -198,7 +229,7 @@ func FindAttachmentByLoadBalancerName(ctx context.Context, conn *autoscaling.Aut
 	}
 
 	return &resource.NotFoundError{
-		LastError: fmt.Errorf("Auto Scaling Group (%s) load balancer (%s) attachment not found", asgName, loadBalancerName),
+		LastError: fmt.Errorf("Auto Scaling Group (%s) load balancer (%s) attachment not found", aws.StringValue(asg.AutoScalingGroupName), loadBalancerName),
 	}
 }
 
@@ -209,6 +240,10 @@ func FindAttachmentByTargetGroupARN(ctx context.Context, conn *autoscaling.AutoS
 		return err
 	}
 
+	return checkAttachmentByTargetGroupARN(asg, targetGroupARN)
+}
+
+func checkAttachmentByTargetGroupARN(asg *autoscaling.Group, targetGroupARN string) error {
 	for _, v := range asg.TargetGroupARNs {
 		if aws.StringValue(v) == targetGroupARN {
 			return nil
@@ -216,6 +251,6 @@ func FindAttachmentByTargetGroupARN(ctx context.Context, conn *autoscaling.AutoS
 	}
 
 	return &resource.NotFoundError{
-		LastError: fmt.Errorf("Auto Scaling Group (%s) target group (%s) attachment not found", asgName, targetGroupARN),
+		LastError: fmt.Errorf("Auto Scaling Group (%s) target group (%s) attachment not found", aws.StringValue(asg.AutoScalingGroupName), targetGroupARN),
 	}
 }
@@ -117,6 +117,41 @@ func TestAccAutoScalingAttachment_multipleALBTargetGroups(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingAttachment_groupRename(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_autoscaling_attachment.test.0"
+	resource2Name := "aws_autoscaling_attachment.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttachmentConfig_groupRename(rName1, rName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttachmentByTargetGroupARNExists(ctx, resource1Name),
+					testAccCheckAttachmentByTargetGroupARNExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "autoscaling_group_name", rName1),
+					resource.TestCheckResourceAttr(resource2Name, "autoscaling_group_name", rName1),
+				),
+			},
+			{
+				Config: testAccAttachmentConfig_groupRename(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttachmentByTargetGroupARNExists(ctx, resource1Name),
+					testAccCheckAttachmentByTargetGroupARNExists(ctx, resource2Name),
+					resource.TestCheckResourceAttr(resource1Name, "autoscaling_group_name", rName2),
+					resource.TestCheckResourceAttr(resource2Name, "autoscaling_group_name", rName2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAttachmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
@@ -305,3 +340,59 @@ resource "aws_autoscaling_attachment" "test" {
 }
 `, n))
 }
+
+func testAccAttachmentConfig_groupRename(rName, groupName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  count = 2
+
+  # "name" cannot be longer than 32 characters.
+  name     = format("%%s-%%d", substr(%[1]q, 0, 28), count.index)
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+resource "aws_launch_configuration" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "test" {
+  vpc_zone_identifier       = aws_subnet.test[*].id
+  max_size                  = 1
+  min_size                  = 0
+  desired_capacity          = 0
+  health_check_grace_period = 300
+  force_delete              = true
+  name                      = %[2]q
+  launch_configuration      = aws_launch_configuration.test.name
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+
+  lifecycle {
+    create_before_destroy = true
+    ignore_changes        = [target_group_arns]
+  }
+}
+
+resource "aws_autoscaling_attachment" "test" {
+  count = 2
+
+  autoscaling_group_name = aws_autoscaling_group.test.id
+  lb_target_group_arn    = aws_lb_target_group.test[count.index].arn
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, groupName))
+}
@@ -24,6 +24,14 @@ const (
 	DefaultWarmPoolMaxGroupPreparedCapacity = -1
 )
 
+const (
+	groupStatusDeleteInProgress = "Delete in progress"
+)
+
+const (
+	lifecycleHookLifecycleTransitionInstanceLaunching = "autoscaling:EC2_INSTANCE_LAUNCHING"
+)
+
 const (
 	InstanceHealthStatusHealthy   = "Healthy"
 	InstanceHealthStatusUnhealthy = "Unhealthy"
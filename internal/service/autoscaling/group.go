@@ -2283,6 +2283,10 @@ func statusInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 			return nil, "", err
 		}
 
+		// Long-running refreshes are otherwise silent, so report progress on every poll.
+		log.Printf("[INFO] Auto Scaling Group (%s) instance refresh (%s) is %s: %d%% complete, %d instances to update, reason: %s",
+			name, id, aws.StringValue(output.Status), aws.Int64Value(output.PercentageComplete), aws.Int64Value(output.InstancesToUpdate), aws.StringValue(output.StatusReason))
+
 		return output, aws.StringValue(output.Status), nil
 	}
 }
@@ -2470,15 +2474,51 @@ func waitLoadBalancerTargetGroupsRemoved(ctx context.Context, conn *autoscaling.
 }
 
 const (
-	// Maximum amount of time to wait for an InstanceRefresh to be started
-	// Must be at least as long as instanceRefreshCancelledTimeout, since we try to cancel any
-	// existing Instance Refreshes when starting.
-	instanceRefreshStartedTimeout = instanceRefreshCancelledTimeout
-
-	// Maximum amount of time to wait for an Instance Refresh to be Cancelled
-	instanceRefreshCancelledTimeout = 15 * time.Minute
+	// Minimum amount of time to wait for an Instance Refresh to be started or Cancelled.
+	// The effective timeout is extended by the group's launching lifecycle hook heartbeat
+	// and the refresh's checkpoint delay, see instanceRefreshTimeout.
+	instanceRefreshDefaultTimeout = 15 * time.Minute
 )
 
+// instanceRefreshTimeout returns the maximum amount of time to wait for an Instance Refresh.
+// Instances being replaced are held in Pending:Wait until any launching lifecycle hook
+// completes or its heartbeat times out, and the refresh then pauses for the checkpoint delay,
+// so a fixed timeout can expire while the refresh is still making progress.
+func instanceRefreshTimeout(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) time.Duration {
+	name := aws.StringValue(input.AutoScalingGroupName)
+
+	var heartbeat int64
+	hooks, err := findLifecycleHooksByGroupName(ctx, conn, name)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read Auto Scaling Group (%s) lifecycle hooks: %s", name, err)
+	}
+
+	for _, v := range hooks {
+		if aws.StringValue(v.LifecycleTransition) != lifecycleHookLifecycleTransitionInstanceLaunching {
+			continue
+		}
+
+		if v := aws.Int64Value(v.HeartbeatTimeout); v > heartbeat {
+			heartbeat = v
+		}
+	}
+
+	var checkpointDelay int64
+	if input.Preferences != nil {
+		checkpointDelay = aws.Int64Value(input.Preferences.CheckpointDelay)
+	}
+
+	timeout := instanceRefreshDefaultTimeout
+	if v := time.Duration(heartbeat+checkpointDelay) * time.Second; v > timeout {
+		timeout = v
+	}
+
+	log.Printf("[DEBUG] Auto Scaling Group (%s) instance refresh timeout: %s (lifecycle hook heartbeat: %ds, checkpoint delay: %ds)", name, timeout, heartbeat, checkpointDelay)
+
+	return timeout
+}
+
 func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.AutoScaling, name, id string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -3658,7 +3698,7 @@ func flattenWarmPoolInstanceReusePolicy(apiObject *autoscaling.InstanceReusePoli
 	return tfMap
 }
 
-func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, name string) error {
+func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, name string, timeout time.Duration) error {
 	input := &autoscaling.CancelInstanceRefreshInput{
 		AutoScalingGroupName: aws.String(name),
 	}
@@ -3673,7 +3713,7 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 		return fmt.Errorf("cancelling Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	_, err = waitInstanceRefreshCancelled(ctx, conn, name, aws.StringValue(output.InstanceRefreshId), instanceRefreshCancelledTimeout)
+	_, err = waitInstanceRefreshCancelled(ctx, conn, name, aws.StringValue(output.InstanceRefreshId), timeout)
 
 	if err != nil {
 		return fmt.Errorf("waiting for Auto Scaling Group (%s) instance refresh cancel: %w", name, err)
@@ -3685,13 +3725,17 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, n
 func startInstanceRefresh(ctx context.Context, conn *autoscaling.AutoScaling, input *autoscaling.StartInstanceRefreshInput) error {
 	name := aws.StringValue(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	// Must be at least as long as the cancellation timeout, since we try to cancel any
+	// existing Instance Refreshes when starting.
+	timeout := instanceRefreshTimeout(ctx, conn, input)
+
+	_, err := tfresource.RetryWhen(ctx, timeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefreshWithContext(ctx, input)
 		},
 		func(err error) (bool, error) {
 			if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeInstanceRefreshInProgressFault) {
-				if err := cancelInstanceRefresh(ctx, conn, name); err != nil {
+				if err := cancelInstanceRefresh(ctx, conn, name, timeout); err != nil {
 					return false, err
 				}
 
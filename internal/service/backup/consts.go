@@ -44,3 +44,9 @@ func reportSettingTemplate_Values() []string {
 		reportSettingTemplateRestoreJobReport,
 	}
 }
+
+const (
+	vaultLockStateCompliance       = "COMPLIANCE"
+	vaultLockStateComplianceLocked = "COMPLIANCE_LOCKED"
+	vaultLockStateGovernance       = "GOVERNANCE"
+)
@@ -2,8 +2,10 @@ package backup
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -21,12 +23,20 @@ func ResourceVaultLockConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockConfigurationCreate,
 		ReadWithoutTimeout:   resourceVaultLockConfigurationRead,
+		UpdateWithoutTimeout: resourceVaultLockConfigurationUpdate,
 		DeleteWithoutTimeout: resourceVaultLockConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffVaultLockConfigurationImmutable,
+
 		Schema: map[string]*schema.Schema{
+			"acknowledge_irreversible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"backup_vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -43,6 +53,14 @@ func ResourceVaultLockConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(3),
 			},
+			"lock_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lock_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"max_retention_days": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -107,16 +125,36 @@ func resourceVaultLockConfigurationRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set("backup_vault_arn", output.BackupVaultArn)
 	d.Set("backup_vault_name", output.BackupVaultName)
+	if output.LockDate != nil {
+		d.Set("lock_date", aws.TimeValue(output.LockDate).Format(time.RFC3339))
+	} else {
+		d.Set("lock_date", nil)
+	}
+	d.Set("lock_state", vaultLockState(output))
 	d.Set("max_retention_days", output.MaxRetentionDays)
 	d.Set("min_retention_days", output.MinRetentionDays)
 
 	return diags
 }
 
+func resourceVaultLockConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only acknowledge_irreversible can be updated and it is not sent to the API.
+	return resourceVaultLockConfigurationRead(ctx, d, meta)
+}
+
 func resourceVaultLockConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupConn()
 
+	if v, ok := d.GetOk("lock_date"); ok && vaultLockImmutable(v.(string)) {
+		if d.Get("acknowledge_irreversible").(bool) {
+			log.Printf("[WARN] Backup Vault Lock Configuration (%s) is immutable, removing from state only", d.Id())
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Backup Vault Lock Configuration (%s): vault lock is in compliance mode and became immutable at %s. Set acknowledge_irreversible to remove it from state only", d.Id(), v.(string))
+	}
+
 	log.Printf("[DEBUG] Deleting Backup Vault Lock Configuration: %s", d.Id())
 	_, err := conn.DeleteBackupVaultLockConfigurationWithContext(ctx, &backup.DeleteBackupVaultLockConfigurationInput{
 		BackupVaultName: aws.String(d.Id()),
@@ -132,3 +170,51 @@ func resourceVaultLockConfigurationDelete(ctx context.Context, d *schema.Resourc
 
 	return diags
 }
+
+// customizeDiffVaultLockConfigurationImmutable explains why a vault lock in compliance mode can't be changed after its grace period.
+func customizeDiffVaultLockConfigurationImmutable(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	lockDate := diff.Get("lock_date").(string)
+
+	if !vaultLockImmutable(lockDate) {
+		return nil
+	}
+
+	for _, key := range []string{"changeable_for_days", "max_retention_days", "min_retention_days"} {
+		if diff.HasChange(key) {
+			return fmt.Errorf("%s cannot be changed: vault lock is in compliance mode and became immutable at %s", key, lockDate)
+		}
+	}
+
+	return nil
+}
+
+// vaultLockImmutable returns whether a compliance mode vault lock's grace period has expired.
+func vaultLockImmutable(lockDate string) bool {
+	if lockDate == "" {
+		return false
+	}
+
+	v, err := time.Parse(time.RFC3339, lockDate)
+
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().Before(v)
+}
+
+func vaultLockState(output *backup.DescribeBackupVaultOutput) string {
+	if output.LockDate == nil {
+		return vaultLockStateGovernance
+	}
+
+	if time.Now().Before(aws.TimeValue(output.LockDate)) {
+		return vaultLockStateCompliance
+	}
+
+	return vaultLockStateComplianceLocked
+}
@@ -31,7 +31,10 @@ func TestAccBackupVaultLockConfiguration_basic(t *testing.T) {
 				Config: testAccVaultLockConfigurationConfig_all(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockConfigurationExists(ctx, resourceName, &vault),
+					resource.TestCheckResourceAttr(resourceName, "acknowledge_irreversible", "false"),
 					resource.TestCheckResourceAttr(resourceName, "changeable_for_days", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_date"),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "COMPLIANCE"),
 					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "1200"),
 					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
 				),
@@ -41,7 +44,39 @@ func TestAccBackupVaultLockConfiguration_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// These are not returned by the API
-				ImportStateVerifyIgnore: []string{"changeable_for_days"},
+				ImportStateVerifyIgnore: []string{"acknowledge_irreversible", "changeable_for_days"},
+			},
+		},
+	})
+}
+
+func TestAccBackupVaultLockConfiguration_governance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vault backup.DescribeBackupVaultOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_vault_lock_configuration.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, backup.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfigurationConfig_governance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockConfigurationExists(ctx, resourceName, &vault),
+					resource.TestCheckResourceAttr(resourceName, "lock_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "lock_state", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acknowledge_irreversible"},
 			},
 		},
 	})
@@ -135,3 +170,17 @@ resource "aws_backup_vault_lock_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccVaultLockConfigurationConfig_governance(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_vault_lock_configuration" "test" {
+  backup_vault_name  = aws_backup_vault.test.name
+  max_retention_days = 1200
+  min_retention_days = 7
+}
+`, rName)
+}
@@ -214,9 +214,40 @@ func ResourceBudgetAction() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceBudgetActionCustomizeDiff,
 	}
 }
 
+func resourceBudgetActionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	definitionKeys := map[string]string{
+		budgets.ActionTypeApplyIamPolicy:  "iam_action_definition",
+		budgets.ActionTypeApplyScpPolicy:  "scp_action_definition",
+		budgets.ActionTypeRunSsmDocuments: "ssm_action_definition",
+	}
+
+	actionType := diff.Get("action_type").(string)
+	expected, ok := definitionKeys[actionType]
+
+	if !ok {
+		return nil
+	}
+
+	for _, key := range []string{"iam_action_definition", "scp_action_definition", "ssm_action_definition"} {
+		v := diff.Get("definition.0." + key).([]interface{})
+
+		if key == expected && len(v) == 0 {
+			return fmt.Errorf("definition.0.%s is required when action_type is %s", key, actionType)
+		}
+
+		if key != expected && len(v) > 0 {
+			return fmt.Errorf("definition.0.%s can't be set when action_type is %s", key, actionType)
+		}
+	}
+
+	return nil
+}
+
 func resourceBudgetActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).BudgetsConn()
 
@@ -345,7 +376,9 @@ func resourceBudgetActionUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.Subscribers = expandBudgetActionSubscriber(d.Get("subscriber").(*schema.Set))
 	}
 
-	_, err = conn.UpdateBudgetActionWithContext(ctx, input)
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.UpdateBudgetActionWithContext(ctx, input)
+	}, budgets.ErrCodeAccessDeniedException)
 
 	if err != nil {
 		return diag.Errorf("updating Budget Action (%s): %s", d.Id(), err)
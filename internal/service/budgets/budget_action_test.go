@@ -57,6 +57,24 @@ func TestAccBudgetsBudgetAction_basic(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudgetAction_definitionMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, budgets.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, budgets.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBudgetActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBudgetActionConfig_definitionMismatch(rName),
+				ExpectError: regexp.MustCompile(`definition.0.ssm_action_definition is required when action_type is RUN_SSM_DOCUMENTS`),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudgetAction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -226,3 +244,36 @@ resource "aws_budgets_budget_action" "test" {
 }
 `, rName)
 }
+
+func testAccBudgetActionConfig_definitionMismatch(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_budgets_budget_action" "test" {
+  budget_name        = %[1]q
+  action_type        = "RUN_SSM_DOCUMENTS"
+  approval_model     = "MANUAL"
+  notification_type  = "ACTUAL"
+  execution_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+
+  action_threshold {
+    action_threshold_type  = "ABSOLUTE_VALUE"
+    action_threshold_value = 100
+  }
+
+  definition {
+    iam_action_definition {
+      policy_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:policy/%[1]s"
+      roles      = [%[1]q]
+    }
+  }
+
+  subscriber {
+    address           = "test@test.test"
+    subscription_type = "EMAIL"
+  }
+}
+`, rName)
+}
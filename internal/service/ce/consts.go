@@ -5,5 +5,6 @@ const (
 	ResNameAnomalySubscription = "Anomaly Subscription"
 	ResNameCostCategory        = "Cost Category"
 	ResNameCostAllocationTag   = "Cost Allocation Tags"
+	ResNameCostAllocationTags  = "Cost Allocation Tags Batch"
 	DSNameTags                 = "Tags Data Source"
 )
@@ -2,9 +2,11 @@ package ce
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/costexplorer"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -68,7 +70,9 @@ func resourceCostAllocationTagRead(ctx context.Context, d *schema.ResourceData,
 func resourceCostAllocationTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	key := d.Get("tag_key").(string)
 
-	updateTagStatus(ctx, d, meta, false)
+	if diags := updateTagStatus(ctx, d, meta, false); diags.HasError() {
+		return diags
+	}
 
 	d.SetId(key)
 
@@ -92,15 +96,48 @@ func updateTagStatus(ctx context.Context, d *schema.ResourceData, meta interface
 		tagStatus.Status = aws.String(costexplorer.CostAllocationTagStatusInactive)
 	}
 
-	input := &costexplorer.UpdateCostAllocationTagsStatusInput{
-		CostAllocationTagsStatus: []*costexplorer.CostAllocationTagStatusEntry{tagStatus},
-	}
-
-	_, err := conn.UpdateCostAllocationTagsStatusWithContext(ctx, input)
+	err := updateCostAllocationTagsStatus(ctx, conn, []*costexplorer.CostAllocationTagStatusEntry{tagStatus})
 
 	if err != nil {
-		return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostAllocationTag, d.Id(), err)
+		return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostAllocationTag, key, err)
 	}
 
 	return nil
 }
+
+// updateCostAllocationTagsStatus updates the status of the specified cost allocation tags
+// in batches, returning any per-tag errors reported by the API.
+func updateCostAllocationTagsStatus(ctx context.Context, conn *costexplorer.CostExplorer, entries []*costexplorer.CostAllocationTagStatusEntry) error {
+	// UpdateCostAllocationTagsStatus accepts at most 20 tags per call.
+	const batchSize = 20
+	var errs *multierror.Error
+
+	for i := 0; i < len(entries); i += batchSize {
+		j := i + batchSize
+		if j > len(entries) {
+			j = len(entries)
+		}
+
+		output, err := conn.UpdateCostAllocationTagsStatusWithContext(ctx, &costexplorer.UpdateCostAllocationTagsStatusInput{
+			CostAllocationTagsStatus: entries[i:j],
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		for _, v := range output.Errors {
+			if v == nil {
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s): %s: %s", aws.StringValue(v.TagKey), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,146 @@
+package ce
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ce_cost_allocation_tags")
+func ResourceCostAllocationTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCostAllocationTagsCreate,
+		ReadWithoutTimeout:   resourceCostAllocationTagsRead,
+		UpdateWithoutTimeout: resourceCostAllocationTagsUpdate,
+		DeleteWithoutTimeout: resourceCostAllocationTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(costexplorer.CostAllocationTagStatus_Values(), false),
+				},
+			},
+			"types": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCostAllocationTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn()
+
+	id := resource.UniqueId()
+
+	if err := updateCostAllocationTagsStatus(ctx, conn, expandCostAllocationTagStatusEntries(d.Get("tags").(map[string]interface{}))); err != nil {
+		return create.DiagError(names.CE, create.ErrActionCreating, ResNameCostAllocationTags, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceCostAllocationTagsRead(ctx, d, meta)
+}
+
+func resourceCostAllocationTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn()
+
+	var keys []string
+	for k := range d.Get("tags").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	costAllocTags, err := FindCostAllocationTagsByKeys(ctx, conn, keys)
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, ResNameCostAllocationTags, d.Id(), err)
+	}
+
+	if !d.IsNewResource() && len(costAllocTags) == 0 {
+		create.LogNotFoundRemoveState(names.CE, create.ErrActionReading, ResNameCostAllocationTags, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tags := make(map[string]interface{}, len(costAllocTags))
+	types := make(map[string]interface{}, len(costAllocTags))
+	for _, v := range costAllocTags {
+		key := aws.StringValue(v.TagKey)
+		tags[key] = aws.StringValue(v.Status)
+		types[key] = aws.StringValue(v.Type)
+	}
+
+	d.Set("tags", tags)
+	d.Set("types", types)
+
+	return nil
+}
+
+func resourceCostAllocationTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn()
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		om, nm := o.(map[string]interface{}), n.(map[string]interface{})
+		update := make(map[string]interface{})
+
+		for k, v := range nm {
+			if ov, ok := om[k]; !ok || ov != v {
+				update[k] = v
+			}
+		}
+
+		// Deactivate tags that are no longer managed.
+		for k := range om {
+			if _, ok := nm[k]; !ok {
+				update[k] = costexplorer.CostAllocationTagStatusInactive
+			}
+		}
+
+		if err := updateCostAllocationTagsStatus(ctx, conn, expandCostAllocationTagStatusEntries(update)); err != nil {
+			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostAllocationTags, d.Id(), err)
+		}
+	}
+
+	return resourceCostAllocationTagsRead(ctx, d, meta)
+}
+
+func resourceCostAllocationTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn()
+
+	tags := make(map[string]interface{})
+	for k := range d.Get("tags").(map[string]interface{}) {
+		tags[k] = costexplorer.CostAllocationTagStatusInactive
+	}
+
+	if err := updateCostAllocationTagsStatus(ctx, conn, expandCostAllocationTagStatusEntries(tags)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionDeleting, ResNameCostAllocationTags, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCostAllocationTagStatusEntries(tfMap map[string]interface{}) []*costexplorer.CostAllocationTagStatusEntry {
+	var apiObjects []*costexplorer.CostAllocationTagStatusEntry
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &costexplorer.CostAllocationTagStatusEntry{
+			Status: aws.String(v.(string)),
+			TagKey: aws.String(k),
+		})
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,105 @@
+package ce_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+)
+
+func TestAccCECostAllocationTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ce_cost_allocation_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAllocationTagsConfig_basic("Active", "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostAllocationTagsStatus(ctx, map[string]string{"Tag01": "Active", "Tag02": "Active"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Tag01", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Tag02", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "types.Tag01", "UserDefined"),
+				),
+			},
+			{
+				Config: testAccCostAllocationTagsConfig_basic("Active", "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostAllocationTagsStatus(ctx, map[string]string{"Tag01": "Active", "Tag02": "Inactive"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Tag02", "Inactive"),
+				),
+			},
+			{
+				Config: testAccCostAllocationTagsConfig_single("Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCostAllocationTagsStatus(ctx, map[string]string{"Tag01": "Active", "Tag02": "Inactive"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCostAllocationTagsStatus(ctx context.Context, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CEConn()
+
+		var keys []string
+		for k := range want {
+			keys = append(keys, k)
+		}
+
+		costAllocTags, err := tfce.FindCostAllocationTagsByKeys(ctx, conn, keys)
+
+		if err != nil {
+			return err
+		}
+
+		got := make(map[string]string)
+		for _, v := range costAllocTags {
+			got[aws.StringValue(v.TagKey)] = aws.StringValue(v.Status)
+		}
+
+		for k, v := range want {
+			if got[k] != v {
+				return fmt.Errorf("cost allocation tag (%s) status: expected %q, got %q", k, v, got[k])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCostAllocationTagsConfig_basic(status1, status2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_allocation_tags" "test" {
+  tags = {
+    Tag01 = %[1]q
+    Tag02 = %[2]q
+  }
+}
+`, status1, status2)
+}
+
+func testAccCostAllocationTagsConfig_single(status string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_allocation_tags" "test" {
+  tags = {
+    Tag01 = %[1]q
+  }
+}
+`, status)
+}
@@ -88,6 +88,53 @@ func FindCostAllocationTagByKey(ctx context.Context, conn *costexplorer.CostExpl
 	return out.CostAllocationTags[0], nil
 }
 
+// FindCostAllocationTagsByKeys returns the cost allocation tags with the specified keys.
+// Keys that are not known to Cost Explorer are omitted from the result.
+func FindCostAllocationTagsByKeys(ctx context.Context, conn *costexplorer.CostExplorer, keys []string) ([]*costexplorer.CostAllocationTag, error) {
+	// ListCostAllocationTags accepts at most 100 tag keys per request.
+	const (
+		batchSize = 100
+	)
+	var output []*costexplorer.CostAllocationTag
+
+	for i := 0; i < len(keys); i += batchSize {
+		j := i + batchSize
+		if j > len(keys) {
+			j = len(keys)
+		}
+
+		in := &costexplorer.ListCostAllocationTagsInput{
+			TagKeys: aws.StringSlice(keys[i:j]),
+		}
+
+		for {
+			out, err := conn.ListCostAllocationTagsWithContext(ctx, in)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if out == nil {
+				break
+			}
+
+			for _, v := range out.CostAllocationTags {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+
+			if aws.StringValue(out.NextToken) == "" {
+				break
+			}
+
+			in.NextToken = out.NextToken
+		}
+	}
+
+	return output, nil
+}
+
 func FindCostCategoryByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.CostCategory, error) {
 	in := &costexplorer.DescribeCostCategoryDefinitionInput{
 		CostCategoryArn: aws.String(arn),
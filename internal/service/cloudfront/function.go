@@ -2,7 +2,9 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -57,6 +59,26 @@ func ResourceFunction() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"publish_gate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_object": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"max_compute_utilization": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
 			"runtime": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -94,6 +116,12 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(aws.StringValue(output.FunctionSummary.Name))
 
 	if d.Get("publish").(bool) {
+		if v, ok := d.GetOk("publish_gate"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := testFunction(ctx, conn, d.Id(), aws.StringValue(output.ETag), v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "publishing CloudFront Function (%s): %s", d.Id(), err)
+			}
+		}
+
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
 			IfMatch: output.ETag,
@@ -184,6 +212,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if d.Get("publish").(bool) {
+		if v, ok := d.GetOk("publish_gate"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := testFunction(ctx, conn, d.Id(), etag, v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "publishing CloudFront Function (%s): %s", d.Id(), err)
+			}
+		}
+
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
 			IfMatch: aws.String(etag),
@@ -220,3 +254,45 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+// testFunction runs the function's DEVELOPMENT stage against the publish gate's event object.
+// An error is returned if the function fails or exceeds the maximum compute utilization.
+func testFunction(ctx context.Context, conn *cloudfront.CloudFront, name, etag string, tfMap map[string]interface{}) error {
+	input := &cloudfront.TestFunctionInput{
+		EventObject: []byte(tfMap["event_object"].(string)),
+		IfMatch:     aws.String(etag),
+		Name:        aws.String(name),
+		Stage:       aws.String(cloudfront.FunctionStageDevelopment),
+	}
+
+	log.Printf("[DEBUG] Testing CloudFront Function: %s", input)
+	output, err := conn.TestFunctionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("testing DEVELOPMENT stage: %w", err)
+	}
+
+	if output == nil || output.TestResult == nil {
+		return fmt.Errorf("testing DEVELOPMENT stage: %w", tfresource.NewEmptyResultError(input))
+	}
+
+	testResult := output.TestResult
+
+	if v := aws.StringValue(testResult.FunctionErrorMessage); v != "" {
+		return fmt.Errorf("testing DEVELOPMENT stage: function error: %s", v)
+	}
+
+	if v := aws.StringValue(testResult.ComputeUtilization); v != "" {
+		computeUtilization, err := strconv.Atoi(v)
+
+		if err != nil {
+			return fmt.Errorf("testing DEVELOPMENT stage: parsing compute utilization (%s): %w", v, err)
+		}
+
+		if maxComputeUtilization := tfMap["max_compute_utilization"].(int); computeUtilization > maxComputeUtilization {
+			return fmt.Errorf("testing DEVELOPMENT stage: compute utilization (%d) exceeds maximum (%d)", computeUtilization, maxComputeUtilization)
+		}
+	}
+
+	return nil
+}
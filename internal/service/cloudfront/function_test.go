@@ -3,6 +3,7 @@ package cloudfront_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudfront"
@@ -130,6 +131,37 @@ func TestAccCloudFrontFunction_publish(t *testing.T) {
 
 // If you are testing manually and can't wait for deletion, set the
 // TF_TEST_CLOUDFRONT_RETAIN environment variable.
+func TestAccCloudFrontFunction_publishGate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_publishGate(rName, "throw new Error('failed');"),
+				ExpectError: regexp.MustCompile(`function error`),
+			},
+			{
+				Config: testAccFunctionConfig_publishGate(rName, "return event.request;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestCheckResourceAttr(resourceName, "publish_gate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "publish_gate.0.max_compute_utilization", "100"),
+					resource.TestCheckResourceAttr(resourceName, "status", "UNASSOCIATED"),
+					resource.TestCheckResourceAttrPair(resourceName, "live_stage_etag", resourceName, "etag"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontFunction_associated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf cloudfront.DescribeFunctionOutput
@@ -383,6 +415,39 @@ EOT
 `, rName, publish)
 }
 
+func testAccFunctionConfig_publishGate(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-1.0"
+  code    = <<-EOT
+function handler(event) {
+	%[2]s
+}
+EOT
+
+  publish_gate {
+    event_object = jsonencode({
+      version = "1.0"
+      context = {
+        eventType = "viewer-request"
+      }
+      viewer = {
+        ip = "198.51.100.11"
+      }
+      request = {
+        method      = "GET"
+        uri         = "/index.html"
+        headers     = {}
+        cookies     = {}
+        querystring = {}
+      }
+    })
+  }
+}
+`, rName, body)
+}
+
 func testAccFunctionConfig_associated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_distribution" "test" {
@@ -174,10 +174,9 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("upstream") {
-		if v, ok := d.GetOk("upstream"); ok {
-			params.Upstreams = expandUpstreams(v.([]interface{}))
-			needsUpdate = true
-		}
+		// Upstreams are resolved in the order listed. An empty list removes all upstreams.
+		params.Upstreams = expandUpstreams(d.Get("upstream").([]interface{}))
+		needsUpdate = true
 	}
 
 	if needsUpdate {
@@ -188,32 +187,37 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("external_connections") {
-		if v, ok := d.GetOk("external_connections"); ok {
-			externalConnection := v.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.AssociateExternalConnectionInput{
+		// A repository can have at most one external connection, so the old connection must be
+		// removed before a different one is associated.
+		o, n := d.GetChange("external_connections")
+
+		if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+			externalConnection := v[0].(map[string]interface{})
+			input := &codeartifact.DisassociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.AssociateExternalConnectionWithContext(ctx, input)
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "associating external connection to CodeArtifact repository: %s", err)
+			_, err := conn.DisassociateExternalConnectionWithContext(ctx, input)
+			if err != nil && !tfawserr.ErrCodeEquals(err, codeartifact.ErrCodeResourceNotFoundException) {
+				return sdkdiag.AppendErrorf(diags, "disassociating external connection to CodeArtifact repository: %s", err)
 			}
-		} else {
-			oldConn, _ := d.GetChange("external_connections")
-			externalConnection := oldConn.([]interface{})[0].(map[string]interface{})
-			input := &codeartifact.DisassociateExternalConnectionInput{
+		}
+
+		if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+			externalConnection := v[0].(map[string]interface{})
+			input := &codeartifact.AssociateExternalConnectionInput{
 				Repository:         aws.String(d.Get("repository").(string)),
 				Domain:             aws.String(d.Get("domain").(string)),
 				DomainOwner:        aws.String(d.Get("domain_owner").(string)),
 				ExternalConnection: aws.String(externalConnection["external_connection_name"].(string)),
 			}
 
-			_, err := conn.DisassociateExternalConnectionWithContext(ctx, input)
+			_, err := conn.AssociateExternalConnectionWithContext(ctx, input)
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "disassociating external connection to CodeArtifact repository: %s", err)
+				return sdkdiag.AppendErrorf(diags, "associating external connection to CodeArtifact repository: %s", err)
 			}
 		}
 	}
@@ -263,16 +267,12 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("administrator_account", sm.Repository.AdministratorAccount)
 	d.Set("description", sm.Repository.Description)
 
-	if sm.Repository.Upstreams != nil {
-		if err := d.Set("upstream", flattenUpstreams(sm.Repository.Upstreams)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "[WARN] Error setting upstream: %s", err)
-		}
+	if err := d.Set("upstream", flattenUpstreams(sm.Repository.Upstreams)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "[WARN] Error setting upstream: %s", err)
 	}
 
-	if sm.Repository.ExternalConnections != nil {
-		if err := d.Set("external_connections", flattenExternalConnections(sm.Repository.ExternalConnections)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "[WARN] Error setting external_connections: %s", err)
-		}
+	if err := d.Set("external_connections", flattenExternalConnections(sm.Repository.ExternalConnections)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "[WARN] Error setting external_connections: %s", err)
 	}
 
 	tags, err := ListTags(ctx, conn, arn)
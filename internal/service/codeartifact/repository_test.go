@@ -205,6 +205,13 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream1", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -221,7 +228,7 @@ func testAccRepository_externalConnection(t *testing.T) {
 		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccRepositoryConfig_externalConnection(rName),
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -243,7 +250,7 @@ func testAccRepository_externalConnection(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccRepositoryConfig_externalConnection(rName),
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:npmjs"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRepositoryExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
@@ -252,6 +259,15 @@ func testAccRepository_externalConnection(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "external_connections.0.status", "AVAILABLE"),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_externalConnection(rName, "public:pypi"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.external_connection_name", "public:pypi"),
+					resource.TestCheckResourceAttr(resourceName, "external_connections.0.package_format", "pypi"),
+				),
+			},
 		},
 	})
 }
@@ -430,17 +446,17 @@ resource "aws_codeartifact_repository" "test" {
 `, rName)
 }
 
-func testAccRepositoryConfig_externalConnection(rName string) string {
+func testAccRepositoryConfig_externalConnection(rName, externalConnectionName string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_codeartifact_repository" "test" {
   repository = %[1]q
   domain     = aws_codeartifact_domain.test.domain
 
   external_connections {
-    external_connection_name = "public:npmjs"
+    external_connection_name = %[2]q
   }
 }
-`, rName)
+`, rName, externalConnectionName)
 }
 
 func testAccRepositoryConfig_tags1(rName, tagKey1, tagValue1 string) string {
@@ -2,6 +2,8 @@ package codebuild
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/codebuild"
 )
 
 const (
@@ -12,3 +14,20 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Webhook filter types that are not yet defined in the AWS SDK for Go.
+const (
+	webhookFilterTypeReleaseName    = "RELEASE_NAME"
+	webhookFilterTypeRepositoryName = "REPOSITORY_NAME"
+	webhookFilterTypeTagName        = "TAG_NAME"
+	webhookFilterTypeWorkflowName   = "WORKFLOW_NAME"
+)
+
+func webhookFilterType_Values() []string {
+	return append(codebuild.WebhookFilterType_Values(),
+		webhookFilterTypeReleaseName,
+		webhookFilterTypeRepositoryName,
+		webhookFilterTypeTagName,
+		webhookFilterTypeWorkflowName,
+	)
+}
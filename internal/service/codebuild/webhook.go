@@ -59,7 +59,7 @@ func ResourceWebhook() *schema.Resource {
 									"type": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringInSlice(codebuild.WebhookFilterType_Values(), false),
+										ValidateFunc: validation.StringInSlice(webhookFilterType_Values(), false),
 									},
 									"exclude_matched_pattern": {
 										Type:     schema.TypeBool,
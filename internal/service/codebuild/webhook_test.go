@@ -262,6 +262,48 @@ func TestAccCodeBuildWebhook_filterGroup(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildWebhook_filterGroupRelease(t *testing.T) {
+	ctx := acctest.Context(t)
+	var webhook codebuild.Webhook
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, codebuild.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookConfig_filterGroupRelease(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhookExists(ctx, resourceName, &webhook),
+					testAccCheckWebhookFilter(&webhook, [][]*codebuild.WebhookFilter{
+						{
+							{
+								Type:                  aws.String("EVENT"),
+								Pattern:               aws.String("RELEASED, PRERELEASED"),
+								ExcludeMatchedPattern: aws.Bool(false),
+							},
+							{
+								Type:                  aws.String("RELEASE_NAME"),
+								Pattern:               aws.String("^v[0-9]+"),
+								ExcludeMatchedPattern: aws.Bool(false),
+							},
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
 func testAccCheckWebhookFilter(webhook *codebuild.Webhook, expectedFilters [][]*codebuild.WebhookFilter) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if webhook == nil {
@@ -438,3 +480,25 @@ resource "aws_codebuild_webhook" "test" {
 }
 `)
 }
+
+func testAccWebhookConfig_filterGroupRelease(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProjectConfig_basic(rName),
+		`
+resource "aws_codebuild_webhook" "test" {
+  project_name = aws_codebuild_project.test.name
+
+  filter_group {
+    filter {
+      type    = "EVENT"
+      pattern = "RELEASED, PRERELEASED"
+    }
+
+    filter {
+      type    = "RELEASE_NAME"
+      pattern = "^v[0-9]+"
+    }
+  }
+}
+`)
+}
@@ -29,6 +29,8 @@ func ResourcePoolRolesAttachment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourcePoolRolesAttachmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"identity_pool_id": {
 				Type:     schema.TypeString,
@@ -42,8 +44,9 @@ func ResourcePoolRolesAttachment() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"identity_provider": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validRoleMappingsIdentityProvider,
 						},
 						"ambiguous_role_resolution": {
 							Type:     schema.TypeString,
@@ -54,6 +57,7 @@ func ResourcePoolRolesAttachment() *schema.Resource {
 							}, false),
 						},
 						"mapping_rule": {
+							// Rules are evaluated in order, so ordering is significant.
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 25,
@@ -241,6 +245,22 @@ func resourcePoolRolesAttachmentDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+func resourcePoolRolesAttachmentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Validate at plan time when all role mappings are known.
+	// Mappings with unknown values are validated again on apply.
+	if !diff.NewValueKnown("role_mapping") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("role_mapping"); ok {
+		if errors := validateRoleMappings(v.(*schema.Set).List()); len(errors) > 0 {
+			return fmt.Errorf("Error validating ambiguous role resolution: %v", errors)
+		}
+	}
+
+	return nil
+}
+
 // Validating that each role_mapping ambiguous_role_resolution
 // is defined when "type" equals Token or Rules.
 func validateRoleMappings(roleMappings []interface{}) []error {
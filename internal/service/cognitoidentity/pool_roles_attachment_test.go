@@ -95,6 +95,11 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
 					resource.TestCheckResourceAttrSet(resourceName, "identity_pool_id"),
 					resource.TestCheckResourceAttr(resourceName, "role_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "role_mapping.*", map[string]string{
+						"mapping_rule.#":       "2",
+						"mapping_rule.0.claim": "isPaid",
+						"mapping_rule.1.claim": "isFoo",
+					}),
 					resource.TestCheckResourceAttrSet(resourceName, "roles.authenticated"),
 				),
 			},
@@ -152,6 +157,24 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappingsWithAmbiguousRoleReso
 	})
 }
 
+func TestAccCognitoIdentityPoolRolesAttachment_roleMappingsWithIdentityProviderError(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cognitoidentity.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolRolesAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPoolRolesAttachmentConfig_roleMappingsWithIdentityProviderError(name),
+				ExpectError: regexp.MustCompile(`must be in the format cognito-idp.<region>.amazonaws.com/<user pool ID>:<app client ID>`),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPoolRolesAttachment_roleMappingsWithRulesTypeError(t *testing.T) {
 	ctx := acctest.Context(t)
 	name := sdkacctest.RandString(10)
@@ -443,6 +466,24 @@ resource "aws_cognito_identity_pool_roles_attachment" "test" {
 `)
 }
 
+func testAccPoolRolesAttachmentConfig_roleMappingsWithIdentityProviderError(name string) string {
+	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name)+`
+resource "aws_cognito_identity_pool_roles_attachment" "test" {
+  identity_pool_id = aws_cognito_identity_pool.main.id
+
+  role_mapping {
+    identity_provider         = "cognito-idp.%[1]s.amazonaws.com/%[1]s_Zr231apJu"
+    ambiguous_role_resolution = "AuthenticatedRole"
+    type                      = "Token"
+  }
+
+  roles = {
+    "authenticated" = aws_iam_role.authenticated.arn
+  }
+}
+`, acctest.Region())
+}
+
 func testAccPoolRolesAttachmentConfig_roleMappingsWithRulesTypeError(name string) string {
 	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "test" {
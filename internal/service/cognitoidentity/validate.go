@@ -3,6 +3,7 @@ package cognitoidentity
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 )
@@ -74,6 +75,25 @@ func validRoleMappingsAmbiguousRoleResolutionAgainstType(v map[string]interface{
 	return
 }
 
+// Validates the identity provider key of a role mapping, e.g. "graph.facebook.com"
+// or "cognito-idp.us-east-1.amazonaws.com/us-east-1_abcdefghi:app_client_id".
+func validRoleMappingsIdentityProvider(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 1 character", k))
+	}
+
+	if len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	}
+
+	if strings.HasPrefix(value, "cognito-idp.") && !regexp.MustCompile(`^cognito-idp\.[a-z0-9-]+\.amazonaws\.com(\.cn)?/[\w-]+_[0-9A-Za-z]+:[\w+]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be in the format cognito-idp.<region>.amazonaws.com/<user pool ID>:<app client ID>", k, value))
+	}
+
+	return
+}
+
 func validRoleMappingsRulesClaim(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -191,6 +191,41 @@ func TestValidRoleMappingsAmbiguousRoleResolutionAgainstType(t *testing.T) {
 	}
 }
 
+func TestValidRoleMappingsIdentityProvider(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"graph.facebook.com",
+		"accounts.google.com",
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu:7lhlkkfbfb4q5kpp90urffao",      //lintignore:AWSAT003
+		"cognito-idp.cn-north-1.amazonaws.com.cn/cn-north-1_Zr231apJu:7lhlkkfbfb4q5kpp90urffao", //lintignore:AWSAT003
+	}
+
+	for _, s := range validValues {
+		_, errors := validRoleMappingsIdentityProvider(s, "identity_provider")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito Role Mapping Identity Provider: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		strings.Repeat("W", 129), // > 128
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",                          //lintignore:AWSAT003
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu:",                         //lintignore:AWSAT003
+		"cognito-idp.us-east-1.amazonaws.com:us-east-1_Zr231apJu:7lhlkkfbfb4q5kpp90urffao", //lintignore:AWSAT003
+		"cognito-idp.us-east-1.amazon.com/us-east-1_Zr231apJu:7lhlkkfbfb4q5kpp90urffao",    //lintignore:AWSAT003
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1Zr231apJu:7lhlkkfbfb4q5kpp90urffao",  //lintignore:AWSAT003
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validRoleMappingsIdentityProvider(s, "identity_provider")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito Role Mapping Identity Provider: %v", s, errors)
+		}
+	}
+}
+
 func TestValidRoleMappingsRulesConfiguration(t *testing.T) {
 	t.Parallel()
 
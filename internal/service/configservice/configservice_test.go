@@ -98,6 +98,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"recreates":     testAccRemediationConfiguration_recreates,
 			"updates":       testAccRemediationConfiguration_updates,
 			"values":        testAccRemediationConfiguration_values,
+			"validation":    testAccRemediationConfiguration_validation,
 		},
 	}
 
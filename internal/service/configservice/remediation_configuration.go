@@ -38,6 +38,8 @@ func ResourceRemediationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRemediationConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -99,7 +101,7 @@ func ResourceRemediationConfiguration() *schema.Resource {
 						"resource_value": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 256),
+							ValidateFunc: validation.StringInSlice(configservice.ResourceValueType_Values(), false),
 						},
 						"static_value": {
 							Type:     schema.TypeString,
@@ -234,13 +236,27 @@ func resourceRemediationConfigurationRead(ctx context.Context, d *schema.Resourc
 	d.Set("automatic", remediationConfiguration.Automatic)
 	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
 	d.Set("retry_attempt_seconds", remediationConfiguration.RetryAttemptSeconds)
-	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
 
 	if err := d.Set("execution_controls", flattenExecutionControls(remediationConfiguration.ExecutionControls)); err != nil {
 		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRemediationConfiguration, d.Id(), err)
 	}
 
-	if err := d.Set("parameter", flattenRemediationParameterValues(remediationConfiguration.Parameters)); err != nil {
+	// A list of static values with a single element is indistinguishable from a static value in the API response,
+	// so keep whichever form is in the configuration.
+	staticValuesNames := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("parameter").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["static_values"].([]interface{}); ok && len(v) > 0 {
+			staticValuesNames[tfMap["name"].(string)] = struct{}{}
+		}
+	}
+
+	if err := d.Set("parameter", flattenRemediationParameterValues(remediationConfiguration.Parameters, staticValuesNames)); err != nil {
 		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRemediationConfiguration, d.Id(), err)
 	}
 
@@ -287,6 +303,44 @@ func resourceRemediationConfigurationDelete(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceRemediationConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("automatic").(bool) {
+		for _, key := range []string{"maximum_automatic_attempts", "retry_attempt_seconds"} {
+			if diff.NewValueKnown(key) && diff.Get(key).(int) == 0 {
+				return fmt.Errorf("%s is required when automatic is true", key)
+			}
+		}
+	}
+
+	for _, tfMapRaw := range diff.Get("parameter").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var n int
+
+		if v, ok := tfMap["resource_value"].(string); ok && v != "" {
+			n++
+		}
+
+		if v, ok := tfMap["static_value"].(string); ok && v != "" {
+			n++
+		}
+
+		if v, ok := tfMap["static_values"].([]interface{}); ok && len(v) > 0 {
+			n++
+		}
+
+		if n > 1 {
+			return fmt.Errorf("parameter (%s): only one of resource_value, static_value or static_values can be specified", tfMap["name"].(string))
+		}
+	}
+
+	return nil
+}
+
 func expandRemediationParameterValue(tfMap map[string]interface{}) *configservice.RemediationParameterValue {
 	if tfMap == nil {
 		return nil
@@ -371,7 +425,7 @@ func expandExecutionControls(tfMap map[string]interface{}) *configservice.Execut
 	return apiObject
 }
 
-func flattenRemediationParameterValues(parameters map[string]*configservice.RemediationParameterValue) []interface{} {
+func flattenRemediationParameterValues(parameters map[string]*configservice.RemediationParameterValue, staticValuesNames map[string]struct{}) []interface{} {
 	var items []interface{}
 
 	for key, value := range parameters {
@@ -380,11 +434,12 @@ func flattenRemediationParameterValues(parameters map[string]*configservice.Reme
 		if v := value.ResourceValue; v != nil {
 			item["resource_value"] = aws.StringValue(v.Value)
 		}
-		if v := value.StaticValue; v != nil && len(v.Values) > 1 {
-			item["static_values"] = aws.StringValueSlice(v.Values)
-		}
-		if v := value.StaticValue; v != nil && len(v.Values) == 1 {
-			item["static_value"] = aws.StringValue(v.Values[0])
+		if v := value.StaticValue; v != nil {
+			if _, ok := staticValuesNames[key]; ok || len(v.Values) > 1 {
+				item["static_values"] = aws.StringValueSlice(v.Values)
+			} else if len(v.Values) == 1 {
+				item["static_value"] = aws.StringValue(v.Values[0])
+			}
 		}
 
 		items = append(items, item)
@@ -408,10 +463,10 @@ func flattenSSMControls(controls *configservice.SsmControls) []interface{} {
 	}
 	m := make(map[string]interface{})
 	if controls.ConcurrentExecutionRatePercentage != nil {
-		m["concurrent_execution_rate_percentage"] = controls.ConcurrentExecutionRatePercentage
+		m["concurrent_execution_rate_percentage"] = aws.Int64Value(controls.ConcurrentExecutionRatePercentage)
 	}
 	if controls.ErrorPercentage != nil {
-		m["error_percentage"] = controls.ErrorPercentage
+		m["error_percentage"] = aws.Int64Value(controls.ErrorPercentage)
 	}
 	return []interface{}{m}
 }
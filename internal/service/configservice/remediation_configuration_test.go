@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -278,6 +279,28 @@ func testAccRemediationConfiguration_values(t *testing.T) {
 	})
 }
 
+func testAccRemediationConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRemediationConfigurationConfig_automaticNoRetry(rName),
+				ExpectError: regexp.MustCompile(`maximum_automatic_attempts is required when automatic is true`),
+			},
+			{
+				Config:      testAccRemediationConfigurationConfig_parameterMultipleValues(rName),
+				ExpectError: regexp.MustCompile(`only one of resource_value, static_value or static_values can be specified`),
+			},
+		},
+	})
+}
+
 func testAccCheckRemediationConfigurationExists(ctx context.Context, n string, obj *configservice.RemediationConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -646,3 +669,30 @@ EOF
 }
 `, rName, sseAlgorithm, randAttempts, randSeconds, randExecPct, randErrorPct, automatic)
 }
+
+func testAccRemediationConfigurationConfig_automaticNoRetry(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = %[1]q
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+  automatic        = true
+}
+`, rName)
+}
+
+func testAccRemediationConfigurationConfig_parameterMultipleValues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = %[1]q
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+    static_value   = "example"
+  }
+}
+`, rName)
+}
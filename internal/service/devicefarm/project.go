@@ -2,16 +2,20 @@ package devicefarm
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -45,11 +49,54 @@ func ResourceProject() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Required: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceProjectCustomizeDiff,
+			// UpdateProject can't remove a project's VPC configuration.
+			customdiff.ForceNewIfChange("vpc_config", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceProjectCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// VPC configuration is only supported for projects in us-west-2.
+	if v := diff.Get("vpc_config").([]interface{}); len(v) > 0 {
+		if region := meta.(*conns.AWSClient).Region; region != endpoints.UsWest2RegionID {
+			return fmt.Errorf("vpc_config is only supported in the %s region, got %s", endpoints.UsWest2RegionID, region)
+		}
+	}
+
+	return nil
+}
+
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeviceFarmConn()
@@ -65,6 +112,10 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.DefaultJobTimeoutMinutes = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandProjectVPCConfig(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating DeviceFarm Project: %s", name)
 	out, err := conn.CreateProjectWithContext(ctx, input)
 	if err != nil {
@@ -106,6 +157,9 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("name", project.Name)
 	d.Set("arn", arn)
 	d.Set("default_job_timeout_minutes", project.DefaultJobTimeoutMinutes)
+	if err := d.Set("vpc_config", flattenProjectVPCConfig(project.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
 
 	tags, err := ListTags(ctx, conn, arn)
 
@@ -144,6 +198,10 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DefaultJobTimeoutMinutes = aws.Int64(int64(d.Get("default_job_timeout_minutes").(int)))
 		}
 
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandProjectVPCConfig(d.Get("vpc_config").([]interface{}))
+		}
+
 		log.Printf("[DEBUG] Updating DeviceFarm Project: %s", d.Id())
 		_, err := conn.UpdateProjectWithContext(ctx, input)
 		if err != nil {
@@ -181,3 +239,33 @@ func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+func expandProjectVPCConfig(l []interface{}) *devicefarm.VpcConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &devicefarm.VpcConfig{
+		VpcId:            aws.String(m["vpc_id"].(string)),
+		SubnetIds:        flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+		SecurityGroupIds: flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
+	}
+
+	return config
+}
+
+func flattenProjectVPCConfig(conf *devicefarm.VpcConfig) []interface{} {
+	if conf == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"vpc_id":             aws.StringValue(conf.VpcId),
+		"subnet_ids":         flex.FlattenStringSet(conf.SubnetIds),
+		"security_group_ids": flex.FlattenStringSet(conf.SecurityGroupIds),
+	}
+
+	return []interface{}{m}
+}
@@ -298,3 +298,27 @@ func FindLocations(ctx context.Context, conn *directconnect.DirectConnect, input
 
 	return output.Locations, nil
 }
+
+func FindMacSecKeyByTwoPartKey(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	connection, err := FindConnectionByID(ctx, conn, connectionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range connection.MacSecKeys {
+		if aws.StringValue(v.SecretARN) != secretARN {
+			continue
+		}
+
+		if state := aws.StringValue(v.State); state == macSecKeyStateDisassociated {
+			return nil, &resource.NotFoundError{
+				Message: state,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
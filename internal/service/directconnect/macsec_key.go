@@ -9,12 +9,22 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// MACsec key states are not defined as constants in the AWS SDK for Go.
+const (
+	macSecKeyStateAssociating    = "associating"
+	macSecKeyStateAssociated     = "associated"
+	macSecKeyStateDisassociating = "disassociating"
+	macSecKeyStateDisassociated  = "disassociated"
 )
 
 // @SDKResource("aws_dx_macsec_key_association")
@@ -100,6 +110,10 @@ func resourceMacSecKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.Set("secret_arn", secret_arn)
 
+	if _, err := waitMacSecKeyAssociated(ctx, conn, aws.StringValue(output.ConnectionId), secret_arn); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) to associate with Direct Connect Connection (%s): %s", secret_arn, *input.ConnectionId, err)
+	}
+
 	return append(diags, resourceMacSecKeyRead(ctx, d, meta)...)
 }
 
@@ -112,25 +126,24 @@ func resourceMacSecKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "unexpected format of ID (%s), expected secretArn_connectionId", d.Id())
 	}
 
-	connection, err := FindConnectionByID(ctx, conn, connId)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s): %s", d.Id(), err)
-	}
+	key, err := FindMacSecKeyByTwoPartKey(ctx, conn, connId, secretArn)
 
-	if connection.MacSecKeys == nil {
-		return sdkdiag.AppendErrorf(diags, "no MACSec keys found on Direct Connect Connection (%s)", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MACSec secret key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
 	}
 
-	for _, key := range connection.MacSecKeys {
-		if aws.StringValue(key.SecretARN) == aws.StringValue(&secretArn) {
-			d.Set("ckn", key.Ckn)
-			d.Set("connection_id", connId)
-			d.Set("secret_arn", key.SecretARN)
-			d.Set("start_on", key.StartOn)
-			d.Set("state", key.State)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MACSec secret key (%s): %s", d.Id(), err)
 	}
 
+	d.Set("ckn", key.Ckn)
+	d.Set("connection_id", connId)
+	d.Set("secret_arn", key.SecretARN)
+	d.Set("start_on", key.StartOn)
+	d.Set("state", key.State)
+
 	return diags
 }
 
@@ -146,10 +159,18 @@ func resourceMacSecKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Disassociating MACSec secret key on Direct Connect Connection: %s", *input.ConnectionId)
 	_, err := conn.DisassociateMacSecKeyWithContext(ctx, input)
 
+	if tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "Could not find Connection with ID") {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Unable to disassociate MACSec secret key on Direct Connect Connection (%s): %s", *input.ConnectionId, err)
 	}
 
+	if _, err := waitMacSecKeyDisassociated(ctx, conn, d.Get("connection_id").(string), d.Get("secret_arn").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) to disassociate from Direct Connect Connection (%s): %s", d.Get("secret_arn").(string), *input.ConnectionId, err)
+	}
+
 	return diags
 }
 
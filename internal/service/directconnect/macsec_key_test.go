@@ -1,6 +1,7 @@
 package directconnect_test
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
@@ -10,7 +11,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccDirectConnectMacSecKey_withCkn(t *testing.T) {
@@ -29,7 +34,7 @@ func TestAccDirectConnectMacSecKey_withCkn(t *testing.T) {
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             nil,
+		CheckDestroy:             testAccCheckMacSecKeyDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMacSecConfig_withCkn(ckn, cak, connectionId),
@@ -70,7 +75,7 @@ func TestAccDirectConnectMacSecKey_withSecret(t *testing.T) {
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, directconnect.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             nil,
+		CheckDestroy:             testAccCheckMacSecKeyDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMacSecConfig_withSecret(secretArn, connectionId),
@@ -89,6 +94,32 @@ func TestAccDirectConnectMacSecKey_withSecret(t *testing.T) {
 }
 
 // testAccDirecConnectMacSecGenerateKey generates a 64-character hex string to be used as CKN or CAK
+func testAccCheckMacSecKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dx_macsec_key_association" {
+				continue
+			}
+
+			_, err := tfdirectconnect.FindMacSecKeyByTwoPartKey(ctx, conn, rs.Primary.Attributes["connection_id"], rs.Primary.Attributes["secret_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Direct Connect MACSec Key Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccDirecConnectMacSecGenerateHex() string {
 	s := make([]byte, 32)
 	if _, err := rand.Read(s); err != nil {
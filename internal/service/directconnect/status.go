@@ -88,3 +88,19 @@ func statusLagState(ctx context.Context, conn *directconnect.DirectConnect, id s
 		return output, aws.StringValue(output.LagState), nil
 	}
 }
+
+func statusMacSecKeyState(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMacSecKeyByTwoPartKey(ctx, conn, connectionID, secretARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
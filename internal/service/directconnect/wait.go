@@ -17,6 +17,8 @@ const (
 	connectionDisassociatedTimeout = 1 * time.Minute
 	hostedConnectionDeletedTimeout = 10 * time.Minute
 	lagDeletedTimeout              = 10 * time.Minute
+	macSecKeyAssociatedTimeout     = 10 * time.Minute
+	macSecKeyDisassociatedTimeout  = 10 * time.Minute
 )
 
 func waitConnectionConfirmed(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) { //nolint:unparam
@@ -36,6 +38,27 @@ func waitConnectionConfirmed(ctx context.Context, conn *directconnect.DirectConn
 	return nil, err
 }
 
+// waitConnectionUpdated waits for a connection to return to the available state after a change,
+// such as a new encryption mode, that bounces the connection.
+func waitConnectionUpdated(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{directconnect.ConnectionStatePending, directconnect.ConnectionStateDown},
+		Target:                    []string{directconnect.ConnectionStateAvailable},
+		Refresh:                   statusConnectionState(ctx, conn, id),
+		Timeout:                   connectionConfirmedTimeout,
+		Delay:                     10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.Connection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitConnectionDeleted(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{directconnect.ConnectionStatePending, directconnect.ConnectionStateOrdering, directconnect.ConnectionStateAvailable, directconnect.ConnectionStateRequested, directconnect.ConnectionStateDeleting},
@@ -181,3 +204,37 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.DirectConnect, id s
 
 	return nil, err
 }
+
+func waitMacSecKeyAssociated(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{macSecKeyStateAssociating},
+		Target:  []string{macSecKeyStateAssociated},
+		Refresh: statusMacSecKeyState(ctx, conn, connectionID, secretARN),
+		Timeout: macSecKeyAssociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.MacSecKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMacSecKeyDisassociated(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string) (*directconnect.MacSecKey, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{macSecKeyStateAssociating, macSecKeyStateAssociated, macSecKeyStateDisassociating},
+		Target:  []string{},
+		Refresh: statusMacSecKeyState(ctx, conn, connectionID, secretARN),
+		Timeout: macSecKeyDisassociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.MacSecKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
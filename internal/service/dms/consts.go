@@ -1,5 +1,11 @@
 package dms
 
+const (
+	connectionStatusFailed     = "failed"
+	connectionStatusSuccessful = "successful"
+	connectionStatusTesting    = "testing"
+)
+
 const (
 	endpointStatusDeleting = "deleting"
 
@@ -38,6 +38,7 @@ func ResourceEndpoint() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -327,6 +328,206 @@ func ResourceEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"mysql_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_connect_script": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"clean_source_metadata_on_mismatch": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"events_poll_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_file_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"parallel_load_threads": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 16),
+						},
+						"server_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"target_db_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.TargetDbType_Values(), false),
+						},
+					},
+				},
+			},
+			"oracle_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_alternate_directly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"add_supplemental_logging": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"additional_archived_log_dest_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"allow_select_nested_tables": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"archived_log_dest_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"archived_logs_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"char_length_semantics": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.CharLengthSemantics_Values(), false),
+						},
+						"convert_timestamp_with_zone_to_utc": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"direct_path_no_log": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"direct_path_parallel_load": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"enable_homogenous_tablespace": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"fail_tasks_on_lob_truncation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"number_datatype_scale": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(-2, 38),
+						},
+						"oracle_path_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"parallel_asm_read_threads": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 8),
+						},
+						"read_ahead_blocks": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1000, 200000),
+						},
+						"read_table_space_name": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"replace_path_prefix": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"retry_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"spatial_data_option_to_geo_json_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"standby_delay_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"trim_space_in_char": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_alternate_folder_for_online": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_b_file": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_direct_path_full_load": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_logminer_reader": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"use_path_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"password": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -338,6 +539,85 @@ func ResourceEndpoint() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn"},
 			},
+			"postgres_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"after_connect_script": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"capture_ddls": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"ddl_artifacts_schema": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"execute_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"fail_tasks_on_lob_truncation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"heartbeat_enable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"heartbeat_frequency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"heartbeat_schema": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"map_boolean_as_boolean": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"max_file_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"plugin_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(dms.PluginNameValue_Values(), false),
+						},
+						"slot_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"trim_space_in_char": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"redis_settings": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -676,6 +956,20 @@ func ResourceEndpoint() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"test_connection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"replication_instance_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"username": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -727,42 +1021,50 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	switch d.Get("engine_name").(string) {
 	case engineNameAurora, engineNameMariadb, engineNameMySQL:
+		settings := &dms.MySQLSettings{}
+
+		if v, ok := d.GetOk("mysql_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandMySQLSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.MySQLSettings = &dms.MySQLSettings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			input.MySQLSettings = &dms.MySQLSettings{
-				Username:     aws.String(d.Get("username").(string)),
-				Password:     aws.String(d.Get("password").(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get("port").(int))),
-				DatabaseName: aws.String(d.Get("database_name").(string)),
-			}
+			settings.Username = aws.String(d.Get("username").(string))
+			settings.Password = aws.String(d.Get("password").(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get("port").(int)))
+			settings.DatabaseName = aws.String(d.Get("database_name").(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.MySQLSettings = settings
 	case engineNameAuroraPostgresql, engineNamePostgres:
+		settings := &dms.PostgreSQLSettings{}
+
+		if v, ok := d.GetOk("postgres_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandPostgreSQLSettings(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-				DatabaseName:                aws.String(d.Get("database_name").(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+			settings.DatabaseName = aws.String(d.Get("database_name").(string))
 		} else {
-			input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-				Username:     aws.String(d.Get("username").(string)),
-				Password:     aws.String(d.Get("password").(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get("port").(int))),
-				DatabaseName: aws.String(d.Get("database_name").(string)),
-			}
+			settings.Username = aws.String(d.Get("username").(string))
+			settings.Password = aws.String(d.Get("password").(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get("port").(int)))
+			settings.DatabaseName = aws.String(d.Get("database_name").(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.PostgreSQLSettings = settings
 	case engineNameDynamoDB:
 		input.DynamoDbSettings = &dms.DynamoDbSettings{
 			ServiceAccessRoleArn: aws.String(d.Get("service_access_role").(string)),
@@ -805,24 +1107,29 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 		input.MongoDbSettings = settings
 	case engineNameOracle:
+		settings := &dms.OracleSettings{}
+
+		if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			settings = expandOracleSettings(v.([]interface{})[0].(map[string]interface{}))
+			expandOracleSettingsZeroValues(d, settings)
+		}
+
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
-			input.OracleSettings = &dms.OracleSettings{
-				SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-				SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-				DatabaseName:                aws.String(d.Get("database_name").(string)),
-			}
+			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+			settings.DatabaseName = aws.String(d.Get("database_name").(string))
 		} else {
-			input.OracleSettings = &dms.OracleSettings{
-				Username:     aws.String(d.Get("username").(string)),
-				Password:     aws.String(d.Get("password").(string)),
-				ServerName:   aws.String(d.Get("server_name").(string)),
-				Port:         aws.Int64(int64(d.Get("port").(int))),
-				DatabaseName: aws.String(d.Get("database_name").(string)),
-			}
+			settings.Username = aws.String(d.Get("username").(string))
+			settings.Password = aws.String(d.Get("password").(string))
+			settings.ServerName = aws.String(d.Get("server_name").(string))
+			settings.Port = aws.Int64(int64(d.Get("port").(int)))
+			settings.DatabaseName = aws.String(d.Get("database_name").(string))
 
 			// Set connection info in top-level namespace as well
 			expandTopLevelConnectionInfo(d, input)
 		}
+
+		input.OracleSettings = settings
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]interface{})[0].(map[string]interface{}))
 	case engineNameRedshift:
@@ -943,6 +1250,20 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(endpointID)
 
+	if v, ok := d.GetOk("test_connection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		endpoint, err := FindEndpointByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DMS Endpoint (%s): %s", d.Id(), err)
+		}
+
+		replicationInstanceARN := v.([]interface{})[0].(map[string]interface{})["replication_instance_arn"].(string)
+
+		if err := testEndpointConnection(ctx, conn, aws.StringValue(endpoint.EndpointArn), replicationInstanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing DMS Endpoint (%s) connection: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceEndpointRead(ctx, d, meta)...)
 }
 
@@ -1029,49 +1350,57 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		case engineNameAurora, engineNameMariadb, engineNameMySQL:
 			if d.HasChanges(
 				"username", "password", "server_name", "port", "database_name", "secrets_manager_access_role_arn",
-				"secrets_manager_arn") {
+				"secrets_manager_arn", "mysql_settings") {
+				settings := &dms.MySQLSettings{}
+
+				if v, ok := d.GetOk("mysql_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					settings = expandMySQLSettings(v.([]interface{})[0].(map[string]interface{}))
+				}
+
 				if _, ok := d.GetOk("secrets_manager_arn"); ok {
-					input.MySQLSettings = &dms.MySQLSettings{
-						SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-						SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-					}
+					settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+					settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 				} else {
-					input.MySQLSettings = &dms.MySQLSettings{
-						Username:     aws.String(d.Get("username").(string)),
-						Password:     aws.String(d.Get("password").(string)),
-						ServerName:   aws.String(d.Get("server_name").(string)),
-						Port:         aws.Int64(int64(d.Get("port").(int))),
-						DatabaseName: aws.String(d.Get("database_name").(string)),
-					}
+					settings.Username = aws.String(d.Get("username").(string))
+					settings.Password = aws.String(d.Get("password").(string))
+					settings.ServerName = aws.String(d.Get("server_name").(string))
+					settings.Port = aws.Int64(int64(d.Get("port").(int)))
+					settings.DatabaseName = aws.String(d.Get("database_name").(string))
 					input.EngineName = aws.String(engineName)
 
 					// Update connection info in top-level namespace as well
 					expandTopLevelConnectionInfoModify(d, input)
 				}
+
+				input.MySQLSettings = settings
 			}
 		case engineNameAuroraPostgresql, engineNamePostgres:
 			if d.HasChanges(
 				"username", "password", "server_name", "port", "database_name", "secrets_manager_access_role_arn",
-				"secrets_manager_arn") {
+				"secrets_manager_arn", "postgres_settings") {
+				settings := &dms.PostgreSQLSettings{}
+
+				if v, ok := d.GetOk("postgres_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					settings = expandPostgreSQLSettings(v.([]interface{})[0].(map[string]interface{}))
+				}
+
 				if _, ok := d.GetOk("secrets_manager_arn"); ok {
-					input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-						DatabaseName:                aws.String(d.Get("database_name").(string)),
-						SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-						SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-					}
+					settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+					settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+					settings.DatabaseName = aws.String(d.Get("database_name").(string))
 				} else {
-					input.PostgreSQLSettings = &dms.PostgreSQLSettings{
-						Username:     aws.String(d.Get("username").(string)),
-						Password:     aws.String(d.Get("password").(string)),
-						ServerName:   aws.String(d.Get("server_name").(string)),
-						Port:         aws.Int64(int64(d.Get("port").(int))),
-						DatabaseName: aws.String(d.Get("database_name").(string)),
-					}
+					settings.Username = aws.String(d.Get("username").(string))
+					settings.Password = aws.String(d.Get("password").(string))
+					settings.ServerName = aws.String(d.Get("server_name").(string))
+					settings.Port = aws.Int64(int64(d.Get("port").(int)))
+					settings.DatabaseName = aws.String(d.Get("database_name").(string))
 					input.EngineName = aws.String(engineName) // Must be included (should be 'postgres')
 
 					// Update connection info in top-level namespace as well
 					expandTopLevelConnectionInfoModify(d, input)
 				}
+
+				input.PostgreSQLSettings = settings
 			}
 		case engineNameDynamoDB:
 			if d.HasChange("service_access_role") {
@@ -1148,26 +1477,31 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		case engineNameOracle:
 			if d.HasChanges(
 				"username", "password", "server_name", "port", "database_name", "secrets_manager_access_role_arn",
-				"secrets_manager_arn") {
+				"secrets_manager_arn", "oracle_settings") {
+				settings := &dms.OracleSettings{}
+
+				if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+					settings = expandOracleSettings(v.([]interface{})[0].(map[string]interface{}))
+					expandOracleSettingsZeroValues(d, settings)
+				}
+
 				if _, ok := d.GetOk("secrets_manager_arn"); ok {
-					input.OracleSettings = &dms.OracleSettings{
-						DatabaseName:                aws.String(d.Get("database_name").(string)),
-						SecretsManagerAccessRoleArn: aws.String(d.Get("secrets_manager_access_role_arn").(string)),
-						SecretsManagerSecretId:      aws.String(d.Get("secrets_manager_arn").(string)),
-					}
+					settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
+					settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
+					settings.DatabaseName = aws.String(d.Get("database_name").(string))
 				} else {
-					input.OracleSettings = &dms.OracleSettings{
-						Username:     aws.String(d.Get("username").(string)),
-						Password:     aws.String(d.Get("password").(string)),
-						ServerName:   aws.String(d.Get("server_name").(string)),
-						Port:         aws.Int64(int64(d.Get("port").(int))),
-						DatabaseName: aws.String(d.Get("database_name").(string)),
-					}
+					settings.Username = aws.String(d.Get("username").(string))
+					settings.Password = aws.String(d.Get("password").(string))
+					settings.ServerName = aws.String(d.Get("server_name").(string))
+					settings.Port = aws.Int64(int64(d.Get("port").(int)))
+					settings.DatabaseName = aws.String(d.Get("database_name").(string))
 					input.EngineName = aws.String(engineName) // Must be included (should be 'oracle')
 
 					// Update connection info in top-level namespace as well
 					expandTopLevelConnectionInfoModify(d, input)
 				}
+
+				input.OracleSettings = settings
 			}
 		case engineNameRedis:
 			if d.HasChanges("redis_settings") {
@@ -1327,6 +1661,14 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating DMS Endpoint (%s): %s", d.Id(), err)
 		}
+
+		if v, ok := d.GetOk("test_connection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			replicationInstanceARN := v.([]interface{})[0].(map[string]interface{})["replication_instance_arn"].(string)
+
+			if err := testEndpointConnection(ctx, conn, d.Get("endpoint_arn").(string), replicationInstanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "testing DMS Endpoint (%s) connection: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -1474,6 +1816,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("mysql_settings", flattenMySQLSettings(endpoint.MySQLSettings)); err != nil {
+			return fmt.Errorf("setting mysql_settings: %w", err)
+		}
 	case engineNameAuroraPostgresql, engineNamePostgres:
 		if endpoint.PostgreSQLSettings != nil {
 			d.Set("username", endpoint.PostgreSQLSettings.Username)
@@ -1485,6 +1830,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("postgres_settings", flattenPostgreSQLSettings(endpoint.PostgreSQLSettings)); err != nil {
+			return fmt.Errorf("setting postgres_settings: %w", err)
+		}
 	case engineNameDynamoDB:
 		if endpoint.DynamoDbSettings != nil {
 			d.Set("service_access_role", endpoint.DynamoDbSettings.ServiceAccessRoleArn)
@@ -1536,6 +1884,9 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *dms.Endpoint) er
 		} else {
 			flattenTopLevelConnectionInfo(d, endpoint)
 		}
+		if err := d.Set("oracle_settings", flattenOracleSettings(endpoint.OracleSettings)); err != nil {
+			return fmt.Errorf("setting oracle_settings: %w", err)
+		}
 	case engineNameRedis:
 		// Auth password isn't returned in API. Propagate state value.
 		tfMap := flattenRedisSettings(endpoint.RedisSettings)
@@ -1896,6 +2247,365 @@ func flattenMongoDBSettings(settings *dms.MongoDbSettings) []map[string]interfac
 	return []map[string]interface{}{m}
 }
 
+// testEndpointConnection tests the connection between an endpoint and a replication instance
+// and returns the failure message reported by DMS if the endpoint can't reach the database.
+func testEndpointConnection(ctx context.Context, conn *dms.DatabaseMigrationService, endpointARN, replicationInstanceARN string, timeout time.Duration) error {
+	input := &dms.TestConnectionInput{
+		EndpointArn:            aws.String(endpointARN),
+		ReplicationInstanceArn: aws.String(replicationInstanceARN),
+	}
+
+	if _, err := conn.TestConnectionWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitConnectionTested(ctx, conn, endpointARN, replicationInstanceARN, timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func expandMySQLSettings(tfMap map[string]interface{}) *dms.MySQLSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.MySQLSettings{}
+
+	if v, ok := tfMap["after_connect_script"].(string); ok && v != "" {
+		apiObject.AfterConnectScript = aws.String(v)
+	}
+	if v, ok := tfMap["clean_source_metadata_on_mismatch"].(bool); ok {
+		apiObject.CleanSourceMetadataOnMismatch = aws.Bool(v)
+	}
+	if v, ok := tfMap["events_poll_interval"].(int); ok && v != 0 {
+		apiObject.EventsPollInterval = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["max_file_size"].(int); ok && v != 0 {
+		apiObject.MaxFileSize = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["parallel_load_threads"].(int); ok && v != 0 {
+		apiObject.ParallelLoadThreads = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["server_timezone"].(string); ok && v != "" {
+		apiObject.ServerTimezone = aws.String(v)
+	}
+	if v, ok := tfMap["target_db_type"].(string); ok && v != "" {
+		apiObject.TargetDbType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMySQLSettings(apiObject *dms.MySQLSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AfterConnectScript; v != nil {
+		tfMap["after_connect_script"] = aws.StringValue(v)
+	}
+	if v := apiObject.CleanSourceMetadataOnMismatch; v != nil {
+		tfMap["clean_source_metadata_on_mismatch"] = aws.BoolValue(v)
+	}
+	if v := apiObject.EventsPollInterval; v != nil {
+		tfMap["events_poll_interval"] = aws.Int64Value(v)
+	}
+	if v := apiObject.MaxFileSize; v != nil {
+		tfMap["max_file_size"] = aws.Int64Value(v)
+	}
+	if v := apiObject.ParallelLoadThreads; v != nil {
+		tfMap["parallel_load_threads"] = aws.Int64Value(v)
+	}
+	if v := apiObject.ServerTimezone; v != nil {
+		tfMap["server_timezone"] = aws.StringValue(v)
+	}
+	if v := apiObject.TargetDbType; v != nil {
+		tfMap["target_db_type"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandOracleSettings(tfMap map[string]interface{}) *dms.OracleSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.OracleSettings{}
+
+	if v, ok := tfMap["access_alternate_directly"].(bool); ok {
+		apiObject.AccessAlternateDirectly = aws.Bool(v)
+	}
+	if v, ok := tfMap["add_supplemental_logging"].(bool); ok {
+		apiObject.AddSupplementalLogging = aws.Bool(v)
+	}
+	if v, ok := tfMap["additional_archived_log_dest_id"].(int); ok && v != 0 {
+		apiObject.AdditionalArchivedLogDestId = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["allow_select_nested_tables"].(bool); ok {
+		apiObject.AllowSelectNestedTables = aws.Bool(v)
+	}
+	if v, ok := tfMap["archived_log_dest_id"].(int); ok && v != 0 {
+		apiObject.ArchivedLogDestId = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["archived_logs_only"].(bool); ok {
+		apiObject.ArchivedLogsOnly = aws.Bool(v)
+	}
+	if v, ok := tfMap["char_length_semantics"].(string); ok && v != "" {
+		apiObject.CharLengthSemantics = aws.String(v)
+	}
+	if v, ok := tfMap["convert_timestamp_with_zone_to_utc"].(bool); ok {
+		apiObject.ConvertTimestampWithZoneToUTC = aws.Bool(v)
+	}
+	if v, ok := tfMap["direct_path_no_log"].(bool); ok {
+		apiObject.DirectPathNoLog = aws.Bool(v)
+	}
+	if v, ok := tfMap["direct_path_parallel_load"].(bool); ok {
+		apiObject.DirectPathParallelLoad = aws.Bool(v)
+	}
+	if v, ok := tfMap["enable_homogenous_tablespace"].(bool); ok {
+		apiObject.EnableHomogenousTablespace = aws.Bool(v)
+	}
+	if v, ok := tfMap["fail_tasks_on_lob_truncation"].(bool); ok {
+		apiObject.FailTasksOnLobTruncation = aws.Bool(v)
+	}
+	if v, ok := tfMap["number_datatype_scale"].(int); ok && v != 0 {
+		apiObject.NumberDatatypeScale = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["oracle_path_prefix"].(string); ok && v != "" {
+		apiObject.OraclePathPrefix = aws.String(v)
+	}
+	if v, ok := tfMap["parallel_asm_read_threads"].(int); ok && v != 0 {
+		apiObject.ParallelAsmReadThreads = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["read_ahead_blocks"].(int); ok && v != 0 {
+		apiObject.ReadAheadBlocks = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["read_table_space_name"].(bool); ok {
+		apiObject.ReadTableSpaceName = aws.Bool(v)
+	}
+	if v, ok := tfMap["replace_path_prefix"].(bool); ok {
+		apiObject.ReplacePathPrefix = aws.Bool(v)
+	}
+	if v, ok := tfMap["retry_interval"].(int); ok && v != 0 {
+		apiObject.RetryInterval = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["spatial_data_option_to_geo_json_function_name"].(string); ok && v != "" {
+		apiObject.SpatialDataOptionToGeoJsonFunctionName = aws.String(v)
+	}
+	if v, ok := tfMap["standby_delay_time"].(int); ok && v != 0 {
+		apiObject.StandbyDelayTime = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["trim_space_in_char"].(bool); ok {
+		apiObject.TrimSpaceInChar = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_alternate_folder_for_online"].(bool); ok {
+		apiObject.UseAlternateFolderForOnline = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_b_file"].(bool); ok {
+		apiObject.UseBFile = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_direct_path_full_load"].(bool); ok {
+		apiObject.UseDirectPathFullLoad = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_logminer_reader"].(bool); ok {
+		apiObject.UseLogminerReader = aws.Bool(v)
+	}
+	if v, ok := tfMap["use_path_prefix"].(string); ok && v != "" {
+		apiObject.UsePathPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+// expandOracleSettingsZeroValues sets the Oracle settings for which a configured value of 0 is meaningful.
+// expandOracleSettings can't tell those apart from unset values.
+func expandOracleSettingsZeroValues(d *schema.ResourceData, apiObject *dms.OracleSettings) {
+	rawConfig := d.GetRawConfig()
+
+	if rawConfig.IsNull() {
+		return
+	}
+
+	settings := rawConfig.GetAttr("oracle_settings")
+
+	if !settings.IsKnown() || settings.IsNull() || settings.LengthInt() == 0 {
+		return
+	}
+
+	setting := settings.Index(cty.NumberIntVal(0))
+
+	if !setting.IsKnown() || setting.IsNull() {
+		return
+	}
+
+	if v := setting.GetAttr("number_datatype_scale"); v.IsKnown() && !v.IsNull() {
+		apiObject.NumberDatatypeScale = aws.Int64(int64(d.Get("oracle_settings.0.number_datatype_scale").(int)))
+	}
+
+	if v := setting.GetAttr("standby_delay_time"); v.IsKnown() && !v.IsNull() {
+		apiObject.StandbyDelayTime = aws.Int64(int64(d.Get("oracle_settings.0.standby_delay_time").(int)))
+	}
+}
+
+func flattenOracleSettings(apiObject *dms.OracleSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"access_alternate_directly":          aws.BoolValue(apiObject.AccessAlternateDirectly),
+		"add_supplemental_logging":           aws.BoolValue(apiObject.AddSupplementalLogging),
+		"allow_select_nested_tables":         aws.BoolValue(apiObject.AllowSelectNestedTables),
+		"archived_logs_only":                 aws.BoolValue(apiObject.ArchivedLogsOnly),
+		"convert_timestamp_with_zone_to_utc": aws.BoolValue(apiObject.ConvertTimestampWithZoneToUTC),
+		"direct_path_no_log":                 aws.BoolValue(apiObject.DirectPathNoLog),
+		"direct_path_parallel_load":          aws.BoolValue(apiObject.DirectPathParallelLoad),
+		"enable_homogenous_tablespace":       aws.BoolValue(apiObject.EnableHomogenousTablespace),
+		"fail_tasks_on_lob_truncation":       aws.BoolValue(apiObject.FailTasksOnLobTruncation),
+		"read_table_space_name":              aws.BoolValue(apiObject.ReadTableSpaceName),
+		"replace_path_prefix":                aws.BoolValue(apiObject.ReplacePathPrefix),
+		"trim_space_in_char":                 aws.BoolValue(apiObject.TrimSpaceInChar),
+		"use_alternate_folder_for_online":    aws.BoolValue(apiObject.UseAlternateFolderForOnline),
+		"use_b_file":                         aws.BoolValue(apiObject.UseBFile),
+		"use_direct_path_full_load":          aws.BoolValue(apiObject.UseDirectPathFullLoad),
+		// LogMiner is used unless Binary Reader is explicitly selected.
+		"use_logminer_reader": apiObject.UseLogminerReader == nil || aws.BoolValue(apiObject.UseLogminerReader),
+	}
+
+	if v := apiObject.AdditionalArchivedLogDestId; v != nil {
+		tfMap["additional_archived_log_dest_id"] = aws.Int64Value(v)
+	}
+	if v := apiObject.ArchivedLogDestId; v != nil {
+		tfMap["archived_log_dest_id"] = aws.Int64Value(v)
+	}
+	if v := apiObject.CharLengthSemantics; v != nil {
+		tfMap["char_length_semantics"] = aws.StringValue(v)
+	}
+	if v := apiObject.NumberDatatypeScale; v != nil {
+		tfMap["number_datatype_scale"] = aws.Int64Value(v)
+	}
+	if v := apiObject.OraclePathPrefix; v != nil {
+		tfMap["oracle_path_prefix"] = aws.StringValue(v)
+	}
+	if v := apiObject.ParallelAsmReadThreads; v != nil {
+		tfMap["parallel_asm_read_threads"] = aws.Int64Value(v)
+	}
+	if v := apiObject.ReadAheadBlocks; v != nil {
+		tfMap["read_ahead_blocks"] = aws.Int64Value(v)
+	}
+	if v := apiObject.RetryInterval; v != nil {
+		tfMap["retry_interval"] = aws.Int64Value(v)
+	}
+	if v := apiObject.SpatialDataOptionToGeoJsonFunctionName; v != nil {
+		tfMap["spatial_data_option_to_geo_json_function_name"] = aws.StringValue(v)
+	}
+	if v := apiObject.StandbyDelayTime; v != nil {
+		tfMap["standby_delay_time"] = aws.Int64Value(v)
+	}
+	if v := apiObject.UsePathPrefix; v != nil {
+		tfMap["use_path_prefix"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandPostgreSQLSettings(tfMap map[string]interface{}) *dms.PostgreSQLSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &dms.PostgreSQLSettings{}
+
+	if v, ok := tfMap["after_connect_script"].(string); ok && v != "" {
+		apiObject.AfterConnectScript = aws.String(v)
+	}
+	if v, ok := tfMap["capture_ddls"].(bool); ok {
+		apiObject.CaptureDdls = aws.Bool(v)
+	}
+	if v, ok := tfMap["ddl_artifacts_schema"].(string); ok && v != "" {
+		apiObject.DdlArtifactsSchema = aws.String(v)
+	}
+	if v, ok := tfMap["execute_timeout"].(int); ok && v != 0 {
+		apiObject.ExecuteTimeout = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["fail_tasks_on_lob_truncation"].(bool); ok {
+		apiObject.FailTasksOnLobTruncation = aws.Bool(v)
+	}
+	if v, ok := tfMap["heartbeat_enable"].(bool); ok {
+		apiObject.HeartbeatEnable = aws.Bool(v)
+	}
+	if v, ok := tfMap["heartbeat_frequency"].(int); ok && v != 0 {
+		apiObject.HeartbeatFrequency = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["heartbeat_schema"].(string); ok && v != "" {
+		apiObject.HeartbeatSchema = aws.String(v)
+	}
+	if v, ok := tfMap["map_boolean_as_boolean"].(bool); ok {
+		apiObject.MapBooleanAsBoolean = aws.Bool(v)
+	}
+	if v, ok := tfMap["max_file_size"].(int); ok && v != 0 {
+		apiObject.MaxFileSize = aws.Int64(int64(v))
+	}
+	if v, ok := tfMap["plugin_name"].(string); ok && v != "" {
+		apiObject.PluginName = aws.String(v)
+	}
+	if v, ok := tfMap["slot_name"].(string); ok && v != "" {
+		apiObject.SlotName = aws.String(v)
+	}
+	if v, ok := tfMap["trim_space_in_char"].(bool); ok {
+		apiObject.TrimSpaceInChar = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenPostgreSQLSettings(apiObject *dms.PostgreSQLSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		// DDL capture is on unless explicitly disabled.
+		"capture_ddls":                 apiObject.CaptureDdls == nil || aws.BoolValue(apiObject.CaptureDdls),
+		"fail_tasks_on_lob_truncation": aws.BoolValue(apiObject.FailTasksOnLobTruncation),
+		"heartbeat_enable":             aws.BoolValue(apiObject.HeartbeatEnable),
+		"map_boolean_as_boolean":       aws.BoolValue(apiObject.MapBooleanAsBoolean),
+		"trim_space_in_char":           aws.BoolValue(apiObject.TrimSpaceInChar),
+	}
+
+	if v := apiObject.AfterConnectScript; v != nil {
+		tfMap["after_connect_script"] = aws.StringValue(v)
+	}
+	if v := apiObject.DdlArtifactsSchema; v != nil {
+		tfMap["ddl_artifacts_schema"] = aws.StringValue(v)
+	}
+	if v := apiObject.ExecuteTimeout; v != nil {
+		tfMap["execute_timeout"] = aws.Int64Value(v)
+	}
+	if v := apiObject.HeartbeatFrequency; v != nil {
+		tfMap["heartbeat_frequency"] = aws.Int64Value(v)
+	}
+	if v := apiObject.HeartbeatSchema; v != nil {
+		tfMap["heartbeat_schema"] = aws.StringValue(v)
+	}
+	if v := apiObject.MaxFileSize; v != nil {
+		tfMap["max_file_size"] = aws.Int64Value(v)
+	}
+	if v := apiObject.PluginName; v != nil {
+		tfMap["plugin_name"] = aws.StringValue(v)
+	}
+	if v := apiObject.SlotName; v != nil {
+		tfMap["slot_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandRedisSettings(tfMap map[string]interface{}) *dms.RedisSettings {
 	if tfMap == nil {
 		return nil
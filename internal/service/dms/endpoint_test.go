@@ -1118,6 +1118,46 @@ func TestAccDMSEndpoint_MySQL_update(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_MySQL_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_mySQLSettings(rName, "UTC", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.events_poll_interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.parallel_load_threads", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.server_timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccEndpointConfig_mySQLSettings(rName, "US/Pacific", 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.parallel_load_threads", "4"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.server_timezone", "US/Pacific"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_Oracle_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -1222,6 +1262,45 @@ func TestAccDMSEndpoint_Oracle_update(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_Oracle_settingsZeroValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_oracleSettings(rName, 10, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.number_datatype_scale", "10"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.standby_delay_time", "5"),
+				),
+			},
+			{
+				Config: testAccEndpointConfig_oracleSettings(rName, 0, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.number_datatype_scale", "0"),
+					resource.TestCheckResourceAttr(resourceName, "oracle_settings.0.standby_delay_time", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_PostgreSQL_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -1342,6 +1421,49 @@ func TestAccDMSEndpoint_PostgreSQL_kmsKey(t *testing.T) {
 	})
 }
 
+func TestAccDMSEndpoint_PostgreSQL_settings(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_postgreSQLSettings(rName, false, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.after_connect_script", "SET search_path TO tftest"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.capture_ddls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_frequency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_schema", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.map_boolean_as_boolean", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccEndpointConfig_postgreSQLSettings(rName, true, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.heartbeat_frequency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.map_boolean_as_boolean", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDMSEndpoint_SQLServer_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_dms_endpoint.test"
@@ -3425,6 +3547,28 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_mySQLSettings(rName, serverTimezone string, parallelLoadThreads int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "mysql"
+  server_name   = "tftest"
+  port          = 3306
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  mysql_settings {
+    events_poll_interval  = 5
+    parallel_load_threads = %[3]d
+    server_timezone       = %[2]q
+  }
+}
+`, rName, serverTimezone, parallelLoadThreads)
+}
+
 func testAccEndpointConfig_mySQLSecretID(rName string) string {
 	return acctest.ConfigCompose(testAccEndpointConfig_secretBase(rName), fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
@@ -3511,6 +3655,27 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_oracleSettings(rName string, numberDatatypeScale, standbyDelayTime int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "oracle"
+  server_name   = "tftest"
+  port          = 27017
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  oracle_settings {
+    number_datatype_scale = %[2]d
+    standby_delay_time    = %[3]d
+  }
+}
+`, rName, numberDatatypeScale, standbyDelayTime)
+}
+
 func testAccEndpointConfig_oracleSecretID(rName string) string {
 	return acctest.ConfigCompose(testAccEndpointConfig_secretBase(rName), fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
@@ -3556,6 +3721,30 @@ resource "aws_dms_endpoint" "test" {
 `, rName)
 }
 
+func testAccEndpointConfig_postgreSQLSettings(rName string, mapBooleanAsBoolean bool, heartbeatFrequency int) string {
+	return fmt.Sprintf(`
+resource "aws_dms_endpoint" "test" {
+  endpoint_id   = %[1]q
+  endpoint_type = "source"
+  engine_name   = "postgres"
+  server_name   = "tftest"
+  port          = 5432
+  username      = "tftest"
+  password      = "tftest"
+  database_name = "tftest"
+  ssl_mode      = "none"
+
+  postgres_settings {
+    after_connect_script   = "SET search_path TO tftest"
+    heartbeat_enable       = true
+    heartbeat_frequency    = %[3]d
+    heartbeat_schema       = "tftest"
+    map_boolean_as_boolean = %[2]t
+  }
+}
+`, rName, mapBooleanAsBoolean, heartbeatFrequency)
+}
+
 func testAccEndpointConfig_postgreSQLSecretID(rName string) string {
 	return acctest.ConfigCompose(testAccEndpointConfig_secretBase(rName), fmt.Sprintf(`
 resource "aws_dms_endpoint" "test" {
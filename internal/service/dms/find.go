@@ -44,6 +44,59 @@ func FindEndpointByID(ctx context.Context, conn *dms.DatabaseMigrationService, i
 	return output.Endpoints[0], nil
 }
 
+func FindConnectionByTwoPartKey(ctx context.Context, conn *dms.DatabaseMigrationService, endpointARN, replicationInstanceARN string) (*dms.Connection, error) {
+	input := &dms.DescribeConnectionsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("endpoint-arn"),
+				Values: aws.StringSlice([]string{endpointARN}),
+			},
+			{
+				Name:   aws.String("replication-instance-arn"),
+				Values: aws.StringSlice([]string{replicationInstanceARN}),
+			},
+		},
+	}
+
+	var results []*dms.Connection
+
+	err := conn.DescribeConnectionsPagesWithContext(ctx, input, func(page *dms.DescribeConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connections {
+			if v == nil {
+				continue
+			}
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
+
 func FindReplicationTaskByID(ctx context.Context, conn *dms.DatabaseMigrationService, id string) (*dms.ReplicationTask, error) {
 	input := &dms.DescribeReplicationTasksInput{
 		Filters: []*dms.Filter{
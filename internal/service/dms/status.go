@@ -9,6 +9,22 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func statusConnection(ctx context.Context, conn *dms.DatabaseMigrationService, endpointARN, replicationInstanceARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConnectionByTwoPartKey(ctx, conn, endpointARN, replicationInstanceARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusEndpoint(ctx context.Context, conn *dms.DatabaseMigrationService, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindEndpointByID(ctx, conn, id)
@@ -2,10 +2,13 @@ package dms
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -13,6 +16,28 @@ const (
 	replicationTaskRunningTimeout = 5 * time.Minute
 )
 
+func waitConnectionTested(ctx context.Context, conn *dms.DatabaseMigrationService, endpointARN, replicationInstanceARN string, timeout time.Duration) (*dms.Connection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{connectionStatusTesting},
+		Target:     []string{connectionStatusSuccessful},
+		Refresh:    statusConnection(ctx, conn, endpointARN, replicationInstanceARN),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dms.Connection); ok {
+		if status := aws.StringValue(output.Status); status == connectionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.LastFailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitEndpointDeleted(ctx context.Context, conn *dms.DatabaseMigrationService, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{endpointStatusDeleting},
@@ -121,6 +121,26 @@ func ResourceClusterInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pending_modified_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ca_cert_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"performance_insights_kms_key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -318,8 +338,17 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("engine_version", db.EngineVersion)
 	d.Set("engine", db.Engine)
 	d.Set("identifier", db.DBInstanceIdentifier)
-	d.Set("instance_class", db.DBInstanceClass)
+	// A deferred (apply_immediately = false) instance class change is reported as pending
+	// until the next maintenance window. Report the pending value so that it isn't re-submitted.
+	if v := db.PendingModifiedValues; v != nil && v.DBInstanceClass != nil {
+		d.Set("instance_class", v.DBInstanceClass)
+	} else {
+		d.Set("instance_class", db.DBInstanceClass)
+	}
 	d.Set("kms_key_id", db.KmsKeyId)
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValues(db.PendingModifiedValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_modified_values: %s", err)
+	}
 	// The AWS API does not expose 'PerformanceInsightsKMSKeyId'  the line below should be uncommented
 	// as soon as it is available in the DescribeDBClusters output.
 	//d.Set("performance_insights_kms_key_id", db.PerformanceInsightsKMSKeyId)
@@ -428,6 +457,12 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for DocDB Instance (%s) update: %s", d.Id(), err)
 		}
+
+		if d.Get("apply_immediately").(bool) && d.HasChange("instance_class") {
+			if err := waitClusterInstancePendingModificationsApplied(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for DocDB Instance (%s) pending modifications: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -484,6 +519,47 @@ func resourceInstanceStateRefreshFunc(ctx context.Context, conn *docdb.DocDB, id
 	}
 }
 
+// clusterInstanceStatusPendingModifications is a pseudo-status reported while an
+// available instance still has an instance class change pending.
+const clusterInstanceStatusPendingModifications = "pending-modifications"
+
+func resourceInstancePendingModificationsRefreshFunc(ctx context.Context, conn *docdb.DocDB, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := resourceInstanceRetrieve(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(v.DBInstanceStatus); status != DBInstanceStatusAvailable {
+			return v, status, nil
+		}
+
+		if p := v.PendingModifiedValues; p != nil && p.DBInstanceClass != nil {
+			return v, clusterInstanceStatusPendingModifications, nil
+		}
+
+		return v, DBInstanceStatusAvailable, nil
+	}
+}
+
+func waitClusterInstancePendingModificationsApplied(ctx context.Context, conn *docdb.DocDB, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    append([]string{clusterInstanceStatusPendingModifications}, resourceClusterInstanceCreateUpdatePendingStates...),
+		Target:     []string{DBInstanceStatusAvailable},
+		Refresh:    resourceInstancePendingModificationsRefreshFunc(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
 // API. It returns an error if there is a communication problem or unexpected
 // error with AWS. When the DBInstance is not found, it returns no error and a
@@ -534,3 +610,17 @@ var resourceClusterInstanceDeletePendingStates = []string{
 	"modifying",
 	"deleting",
 }
+
+func flattenPendingModifiedValues(apiObject *docdb.PendingModifiedValues) []interface{} {
+	if apiObject == nil || (apiObject.CACertificateIdentifier == nil && apiObject.DBInstanceClass == nil && apiObject.EngineVersion == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ca_cert_identifier": aws.StringValue(apiObject.CACertificateIdentifier),
+		"engine_version":     aws.StringValue(apiObject.EngineVersion),
+		"instance_class":     aws.StringValue(apiObject.DBInstanceClass),
+	}
+
+	return []interface{}{tfMap}
+}
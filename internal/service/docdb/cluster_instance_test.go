@@ -68,6 +68,47 @@ func TestAccDocDBClusterInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccDocDBClusterInstance_instanceClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v docdb.DBInstance
+	resourceName := "aws_docdb_cluster_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, docdb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.t3.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.r5.large", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.large"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.t3.medium", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.0.instance_class", "db.t3.medium"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDocDBClusterInstance_performanceInsights(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v docdb.DBInstance
@@ -391,6 +432,25 @@ resource "aws_docdb_cluster_instance" "cluster_instances" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_instanceClass(rName, instanceClass string, applyImmediately bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier  = %[1]q
+  availability_zones  = [data.aws_availability_zones.available.names[0], data.aws_availability_zones.available.names[1], data.aws_availability_zones.available.names[2]]
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_docdb_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_docdb_cluster.test.id
+  instance_class     = %[2]q
+  apply_immediately  = %[3]t
+}
+`, rName, instanceClass, applyImmediately))
+}
+
 func testAccClusterInstanceConfig_performanceInsights(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
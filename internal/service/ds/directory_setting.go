@@ -0,0 +1,156 @@
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameDirectorySetting = "Directory Setting"
+)
+
+// @SDKResource("aws_directory_service_directory_setting")
+func ResourceDirectorySetting() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySettingPut,
+		ReadWithoutTimeout:   resourceDirectorySettingRead,
+		UpdateWithoutTimeout: resourceDirectorySettingPut,
+		DeleteWithoutTimeout: resourceDirectorySettingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_values": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectorySettingPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID, name, value := d.Get("directory_id").(string), d.Get("name").(string), d.Get("value").(string)
+	id := DirectorySettingCreateResourceID(directoryID, name)
+	input := &directoryservice.UpdateSettingsInput{
+		DirectoryId: aws.String(directoryID),
+		Settings: []*directoryservice.Setting{{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		}},
+	}
+
+	log.Printf("[DEBUG] Updating Directory Service Directory Setting: %s", input)
+	if _, err := conn.UpdateSettingsWithContext(ctx, input); err != nil {
+		return create.DiagError(names.DS, create.ErrActionUpdating, ResNameDirectorySetting, id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitSettingUpdated(ctx, conn, directoryID, name, value, timeout); err != nil {
+		return create.DiagError(names.DS, create.ErrActionWaitingForUpdate, ResNameDirectorySetting, id, err)
+	}
+
+	return resourceDirectorySettingRead(ctx, d, meta)
+}
+
+func resourceDirectorySettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DSConn()
+
+	directoryID, name, err := DirectorySettingParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.DS, create.ErrActionReading, ResNameDirectorySetting, d.Id(), err)
+	}
+
+	output, err := FindSetting(ctx, conn, directoryID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory Setting (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.DS, create.ErrActionReading, ResNameDirectorySetting, d.Id(), err)
+	}
+
+	d.Set("allowed_values", output.AllowedValues)
+	d.Set("directory_id", directoryID)
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+	d.Set("value", output.AppliedValue)
+
+	return nil
+}
+
+func resourceDirectorySettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Settings can't be deleted and the API doesn't expose their default values.
+	log.Printf("[WARN] Directory Service Directory Setting (%s) removed from state only, its value is unchanged", d.Id())
+
+	return nil
+}
+
+const directorySettingIDSeparator = "," // nosemgrep:ci.ds-in-const-name,ci.ds-in-var-name
+
+func DirectorySettingCreateResourceID(directoryID, name string) string {
+	parts := []string{directoryID, name}
+	id := strings.Join(parts, directorySettingIDSeparator)
+
+	return id
+}
+
+func DirectorySettingParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, directorySettingIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DirectoryID%[2]sSettingName", id, directorySettingIDSeparator)
+}
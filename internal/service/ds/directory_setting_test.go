@@ -0,0 +1,99 @@
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+)
+
+func TestAccDSDirectorySetting_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.SettingEntry
+	resourceName := "aws_directory_service_directory_setting.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, directoryservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySettingConfig_basic(rName, domainName, "Disable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySettingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "allowed_values"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "TLS_1_0"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Protocol"),
+					resource.TestCheckResourceAttr(resourceName, "value", "Disable"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectorySettingConfig_basic(rName, domainName, "Enable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySettingExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "value", "Enable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySettingExists(ctx context.Context, n string, v *directoryservice.SettingEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Directory Service Directory Setting ID is set")
+		}
+
+		directoryID, name, err := tfds.DirectorySettingParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn()
+
+		output, err := tfds.FindSetting(ctx, conn, directoryID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDirectorySettingConfig_basic(rName, domain, value string) string {
+	return acctest.ConfigCompose(testAccDirectoryConfig_microsoft(rName, domain), fmt.Sprintf(`
+resource "aws_directory_service_directory_setting" "test" {
+  directory_id = aws_directory_service_directory.test.id
+  name         = "TLS_1_0"
+  value        = %[1]q
+}
+`, value))
+}
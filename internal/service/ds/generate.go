@@ -1,4 +1,4 @@
-//go:generate go run ../../generate/listpages/main.go -ListOps=DescribeDirectories,DescribeRegions -ContextOnly
+//go:generate go run ../../generate/listpages/main.go -ListOps=DescribeDirectories,DescribeRegions,DescribeSettings -ContextOnly
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceId -ServiceTagsSlice -TagOp=AddTagsToResource -TagInIDElem=ResourceId -UntagOp=RemoveTagsFromResource -UpdateTags -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
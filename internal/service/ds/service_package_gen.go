@@ -38,6 +38,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceDirectory,
 			TypeName: "aws_directory_service_directory",
 		},
+		{
+			Factory:  ResourceDirectorySetting,
+			TypeName: "aws_directory_service_directory_setting",
+		},
 		{
 			Factory:  ResourceLogSubscription,
 			TypeName: "aws_directory_service_log_subscription",
@@ -100,6 +100,12 @@ func resourceSharedDirectoryAccepterRead(ctx context.Context, d *schema.Resource
 
 	dir, err := FindDirectoryByID(ctx, conn, d.Id())
 
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Shared Directory Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return create.DiagError(names.DS, create.ErrActionReading, ResNameSharedDirectoryAccepter, d.Id(), err)
 	}
@@ -89,6 +89,33 @@ func statusRegion(ctx context.Context, conn *directoryservice.DirectoryService,
 	}
 }
 
+// statusSetting reports a setting whose last request completed but whose applied value
+// doesn't yet match the expected value as still requested.
+func statusSetting(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name, value string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSetting(ctx, conn, directoryID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.RequestStatus)
+
+		switch status {
+		case directoryservice.DirectoryConfigurationStatusDefault, directoryservice.DirectoryConfigurationStatusUpdated:
+			if aws.StringValue(output.AppliedValue) != value {
+				status = directoryservice.DirectoryConfigurationStatusRequested
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
 func statusSharedDirectory(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindSharedDirectory(ctx, conn, ownerDirectoryID, sharedDirectoryID)
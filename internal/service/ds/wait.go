@@ -138,6 +138,27 @@ func waitRegionDeleted(ctx context.Context, conn *directoryservice.DirectoryServ
 	return nil, err
 }
 
+func waitSettingUpdated(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, name, value string, timeout time.Duration) (*directoryservice.SettingEntry, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{directoryservice.DirectoryConfigurationStatusRequested, directoryservice.DirectoryConfigurationStatusUpdating},
+		Target:  []string{directoryservice.DirectoryConfigurationStatusDefault, directoryservice.DirectoryConfigurationStatusUpdated},
+		Refresh: statusSetting(ctx, conn, directoryID, name, value),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SettingEntry); ok {
+		if status := aws.StringValue(output.RequestStatus); status == directoryservice.DirectoryConfigurationStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.RequestStatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.DirectoryService, ownerDirectoryID, sharedDirectoryID string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -164,7 +185,7 @@ func waitSharedDirectoryDeleted(ctx context.Context, conn *directoryservice.Dire
 	return nil, err
 }
 
-func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectoryService, id string, timeout time.Duration) (*directoryservice.SharedDirectory, error) {
+func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectoryService, id string, timeout time.Duration) (*directoryservice.DirectoryDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{directoryservice.ShareStatusPendingAcceptance, directoryservice.ShareStatusSharing},
 		Target:                    []string{directoryservice.ShareStatusShared},
@@ -175,7 +196,7 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*directoryservice.SharedDirectory); ok {
+	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		return output, err
 	}
 
@@ -21,21 +21,49 @@ func ResourceContributorInsights() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContributorInsightsCreate,
 		ReadWithoutTimeout:   resourceContributorInsightsRead,
+		UpdateWithoutTimeout: resourceContributorInsightsUpdate,
 		DeleteWithoutTimeout: resourceContributorInsightsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceContributorInsightsImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"all_indexes": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"index_name"},
+			},
 			"index_name": {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{"all_indexes"},
+			},
+			"index_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
 				Type:     schema.TypeString,
-				ForceNew: true,
-				Optional: true,
+				Computed: true,
 			},
 			"table_name": {
 				Type:     schema.TypeString,
@@ -43,6 +71,8 @@ func ResourceContributorInsights() *schema.Resource {
 				Required: true,
 			},
 		},
+
+		CustomizeDiff: resourceContributorInsightsCustomizeDiff,
 	}
 }
 
@@ -75,6 +105,12 @@ func resourceContributorInsightsCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("waiting for DynamoDB ContributorInsights (%s) create: %s", d.Id(), err)
 	}
 
+	if d.Get("all_indexes").(bool) {
+		if err := enableContributorInsightsForIndexes(ctx, conn, aws.StringValue(output.TableName), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("creating DynamoDB ContributorInsights (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceContributorInsightsRead(ctx, d, meta)
 }
 
@@ -99,11 +135,40 @@ func resourceContributorInsightsRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("index_name", out.IndexName)
+	d.Set("status", out.ContributorInsightsStatus)
 	d.Set("table_name", out.TableName)
 
+	if d.Get("all_indexes").(bool) {
+		indexStatus, err := findContributorInsightsIndexStatus(ctx, conn, tableName)
+
+		if err != nil {
+			return diag.Errorf("reading DynamoDB ContributorInsights (%s): %s", d.Id(), err)
+		}
+
+		if err := d.Set("index_status", indexStatus); err != nil {
+			return diag.Errorf("setting index_status: %s", err)
+		}
+	} else {
+		d.Set("index_status", nil)
+	}
+
 	return nil
 }
 
+func resourceContributorInsightsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	// Only indexes that do not have Contributor Insights enabled are changed.
+	// Turning all_indexes off leaves the indexes' Contributor Insights enabled.
+	if d.Get("all_indexes").(bool) && d.HasChanges("all_indexes", "index_status") {
+		if err := enableContributorInsightsForIndexes(ctx, conn, d.Get("table_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating DynamoDB ContributorInsights (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceContributorInsightsRead(ctx, d, meta)
+}
+
 func resourceContributorInsightsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
@@ -137,9 +202,169 @@ func resourceContributorInsightsDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("waiting for DynamoDB ContributorInsights (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	if err := disableContributorInsightsForIndexes(ctx, conn, tableName, d.Get("index_status").([]interface{}), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("deleting DynamoDB ContributorInsights (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
+// resourceContributorInsightsImport sets all_indexes when Contributor Insights is enabled
+// for the table and for each of its global secondary indexes.
+func resourceContributorInsightsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName, indexName, err := DecodeContributorInsightsID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode DynamoDB ContributorInsights ID (%s): %w", d.Id(), err)
+	}
+
+	if indexName != "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	indexStatus, err := findContributorInsightsIndexStatus(ctx, conn, tableName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading DynamoDB ContributorInsights (%s): %w", d.Id(), err)
+	}
+
+	allIndexes := len(indexStatus) > 0
+	for _, v := range indexStatus {
+		if v.(map[string]interface{})["status"].(string) != dynamodb.ContributorInsightsStatusEnabled {
+			allIndexes = false
+		}
+	}
+
+	d.Set("all_indexes", allIndexes)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceContributorInsightsCustomizeDiff plans an update when all_indexes is enabled or
+// any of the table's global secondary indexes, including ones added since the last apply,
+// do not have Contributor Insights enabled.
+func resourceContributorInsightsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("all_indexes").(bool) {
+		return nil
+	}
+
+	if diff.HasChange("all_indexes") {
+		return diff.SetNewComputed("index_status")
+	}
+
+	for _, v := range diff.Get("index_status").([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok && tfMap["status"].(string) != dynamodb.ContributorInsightsStatusEnabled {
+			return diff.SetNewComputed("index_status")
+		}
+	}
+
+	return nil
+}
+
+// enableContributorInsightsForIndexes enables Contributor Insights for each of the
+// table's global secondary indexes that does not already have it enabled.
+func enableContributorInsightsForIndexes(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, timeout time.Duration) error {
+	indexStatus, err := findContributorInsightsIndexStatus(ctx, conn, tableName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range indexStatus {
+		tfMap := v.(map[string]interface{})
+		indexName := tfMap["index_name"].(string)
+
+		switch tfMap["status"].(string) {
+		case dynamodb.ContributorInsightsStatusEnabled:
+			continue
+		case dynamodb.ContributorInsightsStatusEnabling:
+		default:
+			_, err := conn.UpdateContributorInsightsWithContext(ctx, &dynamodb.UpdateContributorInsightsInput{
+				ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionEnable),
+				IndexName:                 aws.String(indexName),
+				TableName:                 aws.String(tableName),
+			})
+
+			if err != nil {
+				return fmt.Errorf("enabling for index (%s): %w", indexName, err)
+			}
+		}
+
+		if err := waitContributorInsightsCreated(ctx, conn, tableName, indexName, timeout); err != nil {
+			return fmt.Errorf("waiting for index (%s) enable: %w", indexName, err)
+		}
+	}
+
+	return nil
+}
+
+// disableContributorInsightsForIndexes disables Contributor Insights for each of the given
+// global secondary indexes that does not already have it disabled.
+func disableContributorInsightsForIndexes(ctx context.Context, conn *dynamodb.DynamoDB, tableName string, indexStatus []interface{}, timeout time.Duration) error {
+	for _, v := range indexStatus {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok || tfMap["status"].(string) == dynamodb.ContributorInsightsStatusDisabled {
+			continue
+		}
+
+		indexName := tfMap["index_name"].(string)
+		_, err := conn.UpdateContributorInsightsWithContext(ctx, &dynamodb.UpdateContributorInsightsInput{
+			ContributorInsightsAction: aws.String(dynamodb.ContributorInsightsActionDisable),
+			IndexName:                 aws.String(indexName),
+			TableName:                 aws.String(tableName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disabling for index (%s): %w", indexName, err)
+		}
+
+		if err := waitContributorInsightsDeleted(ctx, conn, tableName, indexName, timeout); err != nil {
+			return fmt.Errorf("waiting for index (%s) disable: %w", indexName, err)
+		}
+	}
+
+	return nil
+}
+
+// findContributorInsightsIndexStatus returns the Contributor Insights status of each of the table's global secondary indexes.
+func findContributorInsightsIndexStatus(ctx context.Context, conn *dynamodb.DynamoDB, tableName string) ([]interface{}, error) {
+	table, err := FindTableByName(ctx, conn, tableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tfList []interface{}
+
+	for _, gsi := range table.GlobalSecondaryIndexes {
+		indexName := aws.StringValue(gsi.IndexName)
+		status := dynamodb.ContributorInsightsStatusDisabled
+
+		output, err := FindContributorInsights(ctx, conn, tableName, indexName)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return nil, fmt.Errorf("reading index (%s): %w", indexName, err)
+		default:
+			status = aws.StringValue(output.ContributorInsightsStatus)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"index_name": indexName,
+			"status":     status,
+		})
+	}
+
+	return tfList, nil
+}
+
 func EncodeContributorInsightsID(tableName, indexName, accountID string) string {
 	return fmt.Sprintf("name:%s/index:%s/%s", tableName, indexName, accountID)
 }
@@ -34,6 +34,8 @@ func TestAccDynamoDBContributorInsights_basic(t *testing.T) {
 				Config: testAccContributorInsightsConfig_basic(rName, ""),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckContributorInsightsExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "index_status.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.ContributorInsightsStatusEnabled),
 					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
 				),
 			},
@@ -53,6 +55,52 @@ func TestAccDynamoDBContributorInsights_basic(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBContributorInsights_allIndexes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf dynamodb.DescribeContributorInsightsOutput
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_dynamodb_contributor_insights.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightsConfig_allIndexes(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContributorInsightsExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "all_indexes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "index_status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "index_status.0.index_name", fmt.Sprintf("%s-index-0", rName)),
+					resource.TestCheckResourceAttr(resourceName, "index_status.0.status", dynamodb.ContributorInsightsStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.ContributorInsightsStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The index added to the table shows up as drift on the next plan and is enabled in place.
+				Config:             testAccContributorInsightsConfig_allIndexes(rName, 2),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccContributorInsightsConfig_allIndexes(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckContributorInsightsExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "index_status.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "index_status.0.status", dynamodb.ContributorInsightsStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "index_status.1.status", dynamodb.ContributorInsightsStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBContributorInsights_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf dynamodb.DescribeContributorInsightsOutput
@@ -110,6 +158,39 @@ resource "aws_dynamodb_contributor_insights" "test" {
 `, rName, indexName))
 }
 
+func testAccContributorInsightsConfig_allIndexes(rName string, indexCount int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = %[1]q
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+
+  dynamic "global_secondary_index" {
+    for_each = range(%[2]d)
+
+    content {
+      name            = "%[1]s-index-${global_secondary_index.value}"
+      hash_key        = %[1]q
+      projection_type = "ALL"
+      read_capacity   = 1
+      write_capacity  = 1
+    }
+  }
+}
+
+resource "aws_dynamodb_contributor_insights" "test" {
+  table_name  = aws_dynamodb_table.test.name
+  all_indexes = true
+}
+`, rName, indexCount)
+}
+
 func testAccCheckContributorInsightsExists(ctx context.Context, n string, ci *dynamodb.DescribeContributorInsightsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
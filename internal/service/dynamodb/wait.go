@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -260,7 +262,13 @@ func waitContributorInsightsCreated(ctx context.Context, conn *dynamodb.DynamoDB
 		Refresh: statusContributorInsights(ctx, conn, tableName, indexName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.DescribeContributorInsightsOutput); ok {
+		if v := output.FailureException; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(v.ExceptionName), aws.StringValue(v.ExceptionDescription)))
+		}
+	}
 
 	return err
 }
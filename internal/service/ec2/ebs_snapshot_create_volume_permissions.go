@@ -0,0 +1,391 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_snapshot_create_volume_permissions")
+func ResourceSnapshotCreateVolumePermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSnapshotCreateVolumePermissionsCreate,
+		ReadWithoutTimeout:   resourceSnapshotCreateVolumePermissionsRead,
+		UpdateWithoutTimeout: resourceSnapshotCreateVolumePermissionsUpdate,
+		DeleteWithoutTimeout: resourceSnapshotCreateVolumePermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSnapshotCreateVolumePermissionsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidAccountID},
+				AtLeastOneOf: []string{"account_ids", "group"},
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.PermissionGroup_Values(), false),
+				AtLeastOneOf: []string{"account_ids", "group"},
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCreateVolumePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	snapshotID := d.Get("snapshot_id").(string)
+	accountIDs := d.Get("account_ids").(*schema.Set)
+	group := d.Get("group").(string)
+
+	permissions, err := FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) CreateVolumePermissions: %s", snapshotID, err)
+	}
+
+	// The resource manages all of the snapshot's create volume permissions.
+	// Remove any existing permissions that aren't configured.
+	var delAccountIDs []string
+	var delGroup string
+
+	for _, v := range permissions {
+		if v := aws.StringValue(v.UserId); v != "" && !accountIDs.Contains(v) {
+			delAccountIDs = append(delAccountIDs, v)
+		}
+
+		if v := aws.StringValue(v.Group); v != "" && v != group {
+			delGroup = v
+		}
+	}
+
+	add := expandCreateVolumePermissions(flex.ExpandStringValueSet(accountIDs), group)
+	del := expandCreateVolumePermissions(delAccountIDs, delGroup)
+
+	if err := modifySnapshotCreateVolumePermissions(ctx, conn, snapshotID, add, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EBS Snapshot (%s) CreateVolumePermissions: %s", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, snapshotID, flex.ExpandStringValueSet(accountIDs), group, delAccountIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot (%s) CreateVolumePermissions create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSnapshotCreateVolumePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCreateVolumePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	permissions, err := FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EBS Snapshot (%s) CreateVolumePermissions not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) CreateVolumePermissions: %s", d.Id(), err)
+	}
+
+	var accountIDs []string
+	var group string
+
+	for _, v := range permissions {
+		if v := aws.StringValue(v.UserId); v != "" {
+			accountIDs = append(accountIDs, v)
+		}
+
+		if v := aws.StringValue(v.Group); v != "" {
+			group = v
+		}
+	}
+
+	d.Set("account_ids", accountIDs)
+	d.Set("group", group)
+	d.Set("snapshot_id", d.Id())
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	o, n := d.GetChange("account_ids")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add := flex.ExpandStringValueSet(ns.Difference(os))
+	del := flex.ExpandStringValueSet(os.Difference(ns))
+
+	var addGroup, delGroup string
+	if d.HasChange("group") {
+		o, n := d.GetChange("group")
+		addGroup, delGroup = n.(string), o.(string)
+	}
+
+	if err := modifySnapshotCreateVolumePermissions(ctx, conn, d.Id(), expandCreateVolumePermissions(add, addGroup), expandCreateVolumePermissions(del, delGroup)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EBS Snapshot (%s) CreateVolumePermissions: %s", d.Id(), err)
+	}
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, d.Id(), flex.ExpandStringValueSet(ns), d.Get("group").(string), del, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot (%s) CreateVolumePermissions update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSnapshotCreateVolumePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSnapshotCreateVolumePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	accountIDs := flex.ExpandStringValueSet(d.Get("account_ids").(*schema.Set))
+
+	log.Printf("[DEBUG] Deleting EBS Snapshot CreateVolumePermissions: %s", d.Id())
+	err := modifySnapshotCreateVolumePermissions(ctx, conn, d.Id(), nil, expandCreateVolumePermissions(accountIDs, d.Get("group").(string)))
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidSnapshotNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EBS Snapshot (%s) CreateVolumePermissions: %s", d.Id(), err)
+	}
+
+	if err := waitSnapshotCreateVolumePermissionsPropagated(ctx, conn, d.Id(), nil, "", accountIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot (%s) CreateVolumePermissions delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotCreateVolumePermissionsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if snapshotID := diff.Get("snapshot_id").(string); snapshotID != "" && diff.HasChange("account_ids") && diff.NewValueKnown("account_ids") {
+		snapshot, err := FindSnapshotByID(ctx, conn, snapshotID)
+
+		if err != nil {
+			return fmt.Errorf("reading EBS Snapshot (%s): %w", snapshotID, err)
+		}
+
+		ownerID := aws.StringValue(snapshot.OwnerId)
+
+		for _, v := range diff.Get("account_ids").(*schema.Set).List() {
+			if v.(string) == ownerID {
+				return fmt.Errorf("AWS Account (%s) owns EBS Snapshot (%s)", ownerID, snapshotID)
+			}
+		}
+	}
+
+	if diff.HasChange("group") && diff.Get("group").(string) == ec2.PermissionGroupAll {
+		state, err := FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if err != nil {
+			// The setting may not be available in this partition or the caller may lack permission to read it.
+			// Any block is then reported when the permissions are modified.
+			log.Printf("[WARN] reading EBS snapshot block public access state: %s", err)
+			return nil
+		}
+
+		if err := snapshotBlockPublicAccessError(state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func modifySnapshotCreateVolumePermissions(ctx context.Context, conn *ec2.EC2, snapshotID string, add, remove []*ec2.CreateVolumePermission) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	input := &ec2.ModifySnapshotAttributeInput{
+		Attribute:              aws.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+		CreateVolumePermission: &ec2.CreateVolumePermissionModifications{},
+		SnapshotId:             aws.String(snapshotID),
+	}
+
+	if len(add) > 0 {
+		input.CreateVolumePermission.Add = add
+	}
+
+	if len(remove) > 0 {
+		input.CreateVolumePermission.Remove = remove
+	}
+
+	log.Printf("[DEBUG] Modifying EBS Snapshot CreateVolumePermissions: %s", input)
+	_, err := conn.ModifySnapshotAttributeWithContext(ctx, input)
+
+	if err != nil {
+		for _, v := range add {
+			if aws.StringValue(v.Group) != ec2.PermissionGroupAll {
+				continue
+			}
+
+			// Report a clearer error if public sharing is blocked for the account.
+			if state, stateErr := FindSnapshotBlockPublicAccessState(ctx, conn); stateErr == nil {
+				if blockErr := snapshotBlockPublicAccessError(state); blockErr != nil {
+					return fmt.Errorf("%s: %w", blockErr, err)
+				}
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// waitSnapshotCreateVolumePermissionsPropagated waits for the snapshot's create volume permissions
+// to match the specified account IDs and group.
+// Account IDs that are being removed may still be reported until the removal propagates.
+// Any other unexpected account ID is an error.
+func waitSnapshotCreateVolumePermissionsPropagated(ctx context.Context, conn *ec2.EC2, snapshotID string, accountIDs []string, group string, removedAccountIDs []string, timeout time.Duration) error {
+	expected := make(map[string]bool, len(accountIDs))
+	for _, v := range accountIDs {
+		expected[v] = true
+	}
+
+	removed := make(map[string]bool, len(removedAccountIDs))
+	for _, v := range removedAccountIDs {
+		removed[v] = true
+	}
+
+	return tfresource.Retry(ctx, timeout, func() *resource.RetryError {
+		permissions, err := FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, snapshotID)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		var n, pending int
+		var g string
+
+		for _, v := range permissions {
+			if v := aws.StringValue(v.UserId); v != "" {
+				switch {
+				case expected[v]:
+					n++
+				case removed[v]:
+					pending++
+				default:
+					return resource.NonRetryableError(fmt.Errorf("unexpected AWS Account (%s) has create volume permission", v))
+				}
+			}
+
+			if v := aws.StringValue(v.Group); v != "" {
+				g = v
+			}
+		}
+
+		if pending > 0 {
+			return resource.RetryableError(fmt.Errorf("waiting for %d removed AWS Accounts", pending))
+		}
+
+		if n != len(expected) || g != group {
+			return resource.RetryableError(fmt.Errorf("waiting for %d AWS Accounts and group %q, got %d AWS Accounts and group %q", len(expected), group, n, g))
+		}
+
+		return nil
+	})
+}
+
+func expandCreateVolumePermissions(accountIDs []string, group string) []*ec2.CreateVolumePermission {
+	var apiObjects []*ec2.CreateVolumePermission
+
+	for _, v := range accountIDs {
+		apiObjects = append(apiObjects, &ec2.CreateVolumePermission{
+			UserId: aws.String(v),
+		})
+	}
+
+	if group != "" {
+		apiObjects = append(apiObjects, &ec2.CreateVolumePermission{
+			Group: aws.String(group),
+		})
+	}
+
+	return apiObjects
+}
+
+const (
+	snapshotBlockPublicAccessStateBlockAllSharing = "block-all-sharing"
+	snapshotBlockPublicAccessStateBlockNewSharing = "block-new-sharing"
+)
+
+func snapshotBlockPublicAccessError(state string) error {
+	switch state {
+	case snapshotBlockPublicAccessStateBlockAllSharing, snapshotBlockPublicAccessStateBlockNewSharing:
+		return fmt.Errorf("public sharing of EBS snapshots is blocked in this Region by the account's block public access for snapshots setting (%s). "+
+			"Unblock it in the EC2 console (EC2 Dashboard, Data protection and security) or with the aws ec2 disable-snapshot-block-public-access command before setting group to %q", state, ec2.PermissionGroupAll)
+	}
+
+	return nil
+}
+
+// getSnapshotBlockPublicAccessStateInput and getSnapshotBlockPublicAccessStateOutput model the
+// GetSnapshotBlockPublicAccessState API, which the vendored AWS SDK does not yet include.
+type getSnapshotBlockPublicAccessStateInput struct {
+	_ struct{} `type:"structure"`
+}
+
+type getSnapshotBlockPublicAccessStateOutput struct {
+	_ struct{} `type:"structure"`
+
+	State *string `locationName:"state" type:"string"`
+}
+
+func FindSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.EC2) (string, error) {
+	input := &getSnapshotBlockPublicAccessStateInput{}
+	output := &getSnapshotBlockPublicAccessStateOutput{}
+
+	req := conn.NewRequest(&request.Operation{
+		Name:       "GetSnapshotBlockPublicAccessState",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}, input, output)
+	req.SetContext(ctx)
+
+	if err := req.Send(); err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.State), nil
+}
@@ -0,0 +1,83 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_snapshot_create_volume_permissions")
+func DataSourceSnapshotCreateVolumePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSnapshotCreateVolumePermissionsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_volume_permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceSnapshotCreateVolumePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	snapshotID := d.Get("snapshot_id").(string)
+	permissions, err := FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, snapshotID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) CreateVolumePermissions: %s", snapshotID, err)
+	}
+
+	d.SetId(snapshotID)
+	if err := d.Set("create_volume_permissions", flattenCreateVolumePermissions(permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting create_volume_permissions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenCreateVolumePermissions(apiObjects []*ec2.CreateVolumePermission) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"group":   aws.StringValue(apiObject.Group),
+			"user_id": aws.StringValue(apiObject.UserId),
+		})
+	}
+
+	return tfList
+}
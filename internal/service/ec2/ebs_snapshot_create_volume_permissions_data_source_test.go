@@ -0,0 +1,44 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2EBSSnapshotCreateVolumePermissionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_snapshot_create_volume_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_id", "aws_ebs_snapshot.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_volume_permissions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_volume_permissions.0.group", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "create_volume_permissions.0.user_id", "data.aws_caller_identity.test", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName), `
+data "aws_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_snapshot_create_volume_permissions.test.snapshot_id
+}
+`)
+}
@@ -0,0 +1,231 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_snapshot_create_volume_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "group", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "aws_ebs_snapshot.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_snapshot_create_volume_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSnapshotCreateVolumePermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EBSSnapshotCreateVolumePermissions_group(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_snapshot_create_volume_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheckSnapshotBlockPublicAccessUnblocked(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group", ""),
+				),
+			},
+			{
+				Config: testAccEBSSnapshotCreateVolumePermissionsConfig_group(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotCreateVolumePermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "group", "all"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckSnapshotBlockPublicAccessUnblocked(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+	state, err := tfec2.FindSnapshotBlockPublicAccessState(ctx, conn)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if state != "unblocked" {
+		t.Skipf("skipping acceptance testing: EBS snapshot block public access state is %s", state)
+	}
+}
+
+func testAccCheckSnapshotCreateVolumePermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_snapshot_create_volume_permissions" {
+				continue
+			}
+
+			output, err := tfec2.FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EBS Snapshot %s CreateVolumePermissions still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSnapshotCreateVolumePermissionsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EBS Snapshot CreateVolumePermissions ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		output, err := tfec2.FindSnapshotCreateVolumePermissionsBySnapshotID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("EBS Snapshot %s CreateVolumePermissions not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+`, rName))
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName), `
+resource "aws_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  account_ids = [data.aws_caller_identity.test.account_id]
+}
+`)
+}
+
+func testAccEBSSnapshotCreateVolumePermissionsConfig_group(rName string) string {
+	return acctest.ConfigCompose(testAccEBSSnapshotCreateVolumePermissionsConfig_base(rName), `
+resource "aws_snapshot_create_volume_permissions" "test" {
+  snapshot_id = aws_ebs_snapshot.test.id
+  group       = "all"
+}
+`)
+}
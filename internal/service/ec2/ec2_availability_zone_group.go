@@ -3,9 +3,12 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,12 +22,18 @@ func ResourceAvailabilityZoneGroup() *schema.Resource {
 		CreateWithoutTimeout: resourceAvailabilityZoneGroupCreate,
 		ReadWithoutTimeout:   resourceAvailabilityZoneGroupRead,
 		UpdateWithoutTimeout: resourceAvailabilityZoneGroupUpdate,
-		DeleteWithoutTimeout: schema.NoopContext,
+		DeleteWithoutTimeout: resourceAvailabilityZoneGroupDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(AvailabilityZoneGroupOptInStatusTimeout),
+			Update: schema.DefaultTimeout(AvailabilityZoneGroupOptInStatusTimeout),
+			Delete: schema.DefaultTimeout(AvailabilityZoneGroupOptInStatusTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:     schema.TypeString,
@@ -55,7 +64,7 @@ func resourceAvailabilityZoneGroupCreate(ctx context.Context, d *schema.Resource
 	}
 
 	if v := d.Get("opt_in_status").(string); v != aws.StringValue(availabilityZone.OptInStatus) {
-		if err := modifyAvailabilityZoneOptInStatus(ctx, conn, groupName, v); err != nil {
+		if err := modifyAvailabilityZoneOptInStatus(ctx, conn, groupName, v, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating EC2 Availability Zone Group (%s): %s", groupName, err)
 		}
 	}
@@ -89,14 +98,38 @@ func resourceAvailabilityZoneGroupUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
-	if err := modifyAvailabilityZoneOptInStatus(ctx, conn, d.Id(), d.Get("opt_in_status").(string)); err != nil {
+	if err := modifyAvailabilityZoneOptInStatus(ctx, conn, d.Id(), d.Get("opt_in_status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating EC2 Availability Zone Group (%s): %s", d.Id(), err)
 	}
 
 	return append(diags, resourceAvailabilityZoneGroupRead(ctx, d, meta)...)
 }
 
-func modifyAvailabilityZoneOptInStatus(ctx context.Context, conn *ec2.EC2, groupName, optInStatus string) error {
+func resourceAvailabilityZoneGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	if d.Get("opt_in_status").(string) != ec2.AvailabilityZoneOptInStatusOptedIn {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Opting out of EC2 Availability Zone Group: %s", d.Id())
+	err := modifyAvailabilityZoneOptInStatus(ctx, conn, d.Id(), ec2.AvailabilityZoneOptInStatusNotOptedIn, d.Timeout(schema.TimeoutDelete))
+
+	// Some zone groups, e.g. Local Zones, can only be opted out of by AWS Support.
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidOptInStatus) {
+		log.Printf("[WARN] Unable to opt out of EC2 Availability Zone Group (%s), removing from state only: %s", d.Id(), err)
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Availability Zone Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func modifyAvailabilityZoneOptInStatus(ctx context.Context, conn *ec2.EC2, groupName, optInStatus string, timeout time.Duration) error {
 	input := &ec2.ModifyAvailabilityZoneGroupInput{
 		GroupName:   aws.String(groupName),
 		OptInStatus: aws.String(optInStatus),
@@ -111,7 +144,7 @@ func modifyAvailabilityZoneOptInStatus(ctx context.Context, conn *ec2.EC2, group
 		waiter = WaitAvailabilityZoneGroupNotOptedIn
 	}
 
-	if _, err := waiter(ctx, conn, groupName); err != nil {
+	if _, err := waiter(ctx, conn, groupName, timeout); err != nil {
 		return fmt.Errorf("waiting for completion: %w", err)
 	}
 
@@ -1,13 +1,18 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
 func TestAccEC2AvailabilityZoneGroup_optInStatus(t *testing.T) {
@@ -23,7 +28,8 @@ func TestAccEC2AvailabilityZoneGroup_optInStatus(t *testing.T) {
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsWest2RegionID) },
 		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             nil,
+		// Opting out of Local Zones is not supported, so destroy only removes the resource from state.
+		CheckDestroy: testAccCheckAvailabilityZoneGroupOptInStatus(ctx, ec2.AvailabilityZoneOptInStatusOptedIn),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAvailabilityZoneGroupConfig_optInStatus(localZone, ec2.AvailabilityZoneOptInStatusOptedIn),
@@ -55,6 +61,30 @@ func TestAccEC2AvailabilityZoneGroup_optInStatus(t *testing.T) {
 	})
 }
 
+func testAccCheckAvailabilityZoneGroupOptInStatus(ctx context.Context, optInStatus string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_availability_zone_group" {
+				continue
+			}
+
+			output, err := tfec2.FindAvailabilityZoneGroupByName(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if got := aws.StringValue(output.OptInStatus); got != optInStatus {
+				return fmt.Errorf("EC2 Availability Zone Group %s opt-in status = %s, want %s", rs.Primary.ID, got, optInStatus)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccAvailabilityZoneGroupConfig_optInStatus(name, optInStatus string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "test" {
@@ -325,7 +325,10 @@ func resourceEIPDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.PublicIp = aws.String(d.Id())
 	}
 
-	_, err := conn.ReleaseAddressWithContext(ctx, input)
+	// The address can't be released until any association has been fully torn down.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.ReleaseAddressWithContext(ctx, input)
+	}, errCodeInvalidIPAddressInUse)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
 		return diags
@@ -813,7 +813,9 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	tagSpecifications := tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
-	tagSpecifications = append(tagSpecifications, tagSpecificationsFromMap(ctx, d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
+	// Provider default tags are propagated to all volumes launched with the instance, including those from the AMI's block device mappings.
+	volumeTags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{})))
+	tagSpecifications = append(tagSpecifications, tagSpecificationsFromKeyValueTags(volumeTags, ec2.ResourceTypeVolume)...)
 
 	input := &ec2.RunInstancesInput{
 		BlockDeviceMappings:               instanceOpts.BlockDeviceMappings,
@@ -1159,7 +1161,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		if err := d.Set("volume_tags", KeyValueTags(ctx, volumeTags).IgnoreAWS().Map()); err != nil {
+		if err := d.Set("volume_tags", KeyValueTags(ctx, volumeTags).IgnoreAWS().RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting volume_tags: %s", err)
 		}
 	}
@@ -1173,7 +1175,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 	}
 
-	if err := readBlockDevices(ctx, d, instance, conn); err != nil {
+	if err := readBlockDevices(ctx, d, instance, conn, defaultTagsConfig); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
@@ -1785,6 +1787,12 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("ebs_block_device") && !d.IsNewResource() {
+		if err := updateEBSBlockDeviceTags(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
 	// To modify capacity reservation attributes of an instance, instance state needs to be in ec2.InstanceStateNameStopped,
 	// otherwise the modification will return an IncorrectInstanceState error
 	if d.HasChange("capacity_reservation_specification") && !d.IsNewResource() {
@@ -1940,8 +1948,10 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 	return nil
 }
 
-func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
-	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn)
+// readBlockDevices sets the instance's block devices.
+// Tags matching the provider's default tags are not included in block device tags.
+func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, defaultTagsConfig *tftags.DefaultConfig) error {
+	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn, defaultTagsConfig)
 	if err != nil {
 		return fmt.Errorf("reading block devices: %w", err)
 	}
@@ -2028,7 +2038,7 @@ func disassociateInstanceProfile(ctx context.Context, associationId *string, con
 	return nil
 }
 
-func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) (map[string]interface{}, error) {
+func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2, defaultTagsConfig *tftags.DefaultConfig) (map[string]interface{}, error) {
 	blockDevices := make(map[string]interface{})
 	blockDevices["ebs"] = make([]map[string]interface{}, 0)
 	blockDevices["root"] = nil
@@ -2090,7 +2100,7 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, i
 			bd["device_name"] = aws.StringValue(instanceBd.DeviceName)
 		}
 		if v, ok := d.GetOk("volume_tags"); (!ok || v == nil || len(v.(map[string]interface{})) == 0) && vol.Tags != nil {
-			bd["tags"] = KeyValueTags(ctx, vol.Tags).IgnoreAWS().Map()
+			bd["tags"] = KeyValueTags(ctx, vol.Tags).IgnoreAWS().RemoveDefaultConfig(defaultTagsConfig).Map()
 		}
 
 		if blockDeviceIsRoot(instanceBd, instance) {
@@ -2898,6 +2908,55 @@ func getVolumeIdByDeviceName(instance *ec2.Instance, deviceName string) string {
 	return volumeId
 }
 
+// updateEBSBlockDeviceTags updates the tags of EBS block devices whose configured tags have changed.
+// The volumes are resolved from the instance's block device mappings.
+func updateEBSBlockDeviceTags(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData) error {
+	o, n := d.GetChange("ebs_block_device")
+	oldTags := make(map[string]map[string]interface{})
+
+	for _, v := range o.(*schema.Set).List() {
+		bd := v.(map[string]interface{})
+		oldTags[bd["device_name"].(string)], _ = bd["tags"].(map[string]interface{})
+	}
+
+	var instance *ec2.Instance
+
+	for _, v := range n.(*schema.Set).List() {
+		bd := v.(map[string]interface{})
+		deviceName := bd["device_name"].(string)
+		newTags, _ := bd["tags"].(map[string]interface{})
+
+		if tftags.New(ctx, oldTags[deviceName]).Equal(tftags.New(ctx, newTags)) {
+			continue
+		}
+
+		volumeID, _ := bd["volume_id"].(string)
+
+		if volumeID == "" {
+			if instance == nil {
+				var err error
+				instance, err = FindInstanceByID(ctx, conn, d.Id())
+
+				if err != nil {
+					return fmt.Errorf("reading EC2 Instance (%s): %w", d.Id(), err)
+				}
+			}
+
+			volumeID = getVolumeIdByDeviceName(instance, deviceName)
+		}
+
+		if volumeID == "" {
+			continue
+		}
+
+		if err := UpdateTags(ctx, conn, volumeID, oldTags[deviceName], newTags); err != nil {
+			return fmt.Errorf("updating tags for EBS block device (%s) volume (%s): %w", deviceName, volumeID, err)
+		}
+	}
+
+	return nil
+}
+
 func blockDeviceTagsDefined(d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("root_block_device"); ok {
 		vL := v.([]interface{})
@@ -1590,6 +1590,81 @@ func TestAccEC2Instance_BlockDeviceTags_ebsAndRoot(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_BlockDeviceTags_ebsUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_blockDeviceTagsEBSTagsUpdate(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name":  "/dev/sdb",
+						"tags.%":       "2",
+						"tags.Name":    rName,
+						"tags.Purpose": "test",
+					}),
+					testAccCheckInstanceEBSVolumeTag(ctx, resourceName, "/dev/sdb", "Purpose", "test"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_blockDeviceTagsEBSTagsUpdate(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v2),
+					testAccCheckInstanceNotRecreated(&v1, &v2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name":  "/dev/sdb",
+						"tags.%":       "2",
+						"tags.Name":    rName,
+						"tags.Purpose": "updated",
+					}),
+					testAccCheckInstanceEBSVolumeTag(ctx, resourceName, "/dev/sdb", "Purpose", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_BlockDeviceTags_defaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccInstanceConfig_blockDeviceTagsEBSTagsUpdate(rName, "test"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.tags.%", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ebs_block_device.*", map[string]string{
+						"device_name": "/dev/sdb",
+						"tags.%":      "2",
+					}),
+					testAccCheckInstanceEBSVolumeTag(ctx, resourceName, "/dev/sdb", "providerkey1", "providervalue1"),
+					testAccCheckInstanceEBSVolumeTag(ctx, resourceName, "/dev/sdc", "providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_instanceProfileChange(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -4865,6 +4940,44 @@ func TestAccEC2Instance_CapacityReservation_modifyTarget(t *testing.T) {
 	})
 }
 
+// testAccCheckInstanceEBSVolumeTag checks that the volume attached to an instance at the specified device has a tag.
+func testAccCheckInstanceEBSVolumeTag(ctx context.Context, n, deviceName, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		instance, err := tfec2.FindInstanceByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, bd := range instance.BlockDeviceMappings {
+			if aws.StringValue(bd.DeviceName) != deviceName || bd.Ebs == nil {
+				continue
+			}
+
+			volume, err := tfec2.FindEBSVolumeByID(ctx, conn, aws.StringValue(bd.Ebs.VolumeId))
+
+			if err != nil {
+				return err
+			}
+
+			if v, ok := tfec2.KeyValueTags(ctx, volume.Tags).Map()[key]; !ok || v != value {
+				return fmt.Errorf("EBS volume (%s) tag %s = %q, expected %q", aws.StringValue(volume.VolumeId), key, v, value)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("EC2 Instance (%s) has no EBS volume attached at %s", rs.Primary.ID, deviceName)
+	}
+}
+
 func testAccCheckInstanceNotRecreated(before, after *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.StringValue(before.InstanceId), aws.StringValue(after.InstanceId); before != after {
@@ -6453,6 +6566,35 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_blockDeviceTagsEBSTagsUpdate(rName, purpose string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHVMEBSAMI(), fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+
+  instance_type = "t2.medium"
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_size = 1
+
+    tags = {
+      Name    = %[1]q
+      Purpose = %[2]q
+    }
+  }
+
+  ebs_block_device {
+    device_name = "/dev/sdc"
+    volume_size = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, purpose))
+}
+
 var testAccInstanceConfig_ebsBlockDeviceInvalidIOPS = acctest.ConfigCompose(testAccInstanceAMIWithEBSRootVolume, `
 resource "aws_instance" "test" {
   ami = data.aws_ami.ami.id
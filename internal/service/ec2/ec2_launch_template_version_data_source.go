@@ -0,0 +1,161 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_launch_template_version")
+func DataSourceLaunchTemplateVersion() *schema.Resource {
+	// The launch template data attributes are the same as those of the aws_launch_template data source.
+	s := DataSourceLaunchTemplate().Schema
+	for _, k := range []string{"arn", "default_version", "description", "filter", "id", "latest_version", "name", "tags"} {
+		delete(s, k)
+	}
+
+	s["create_time"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["created_by"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["default"] = &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"filter", "latest", "version", "version_description"},
+	}
+	s["default_version"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	s["filter"] = DataSourceFiltersSchema()
+	s["filter"].ConflictsWith = []string{"default", "latest", "version"}
+	s["latest"] = &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"default", "filter", "version", "version_description"},
+	}
+	s["launch_template_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["launch_template_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["version"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"default", "filter", "latest", "version_description"},
+	}
+	s["version_description"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"default", "latest", "version"},
+	}
+	s["version_number"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLaunchTemplateVersionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.Versions = aws.StringSlice([]string{v.(string)})
+	} else if d.Get("latest").(bool) {
+		input.Versions = aws.StringSlice([]string{LaunchTemplateVersionLatest})
+	} else if d.Get("default").(bool) {
+		input.Versions = aws.StringSlice([]string{LaunchTemplateVersionDefault})
+	}
+
+	input.Filters = BuildFiltersDataSource(d.Get("filter").(*schema.Set))
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	ltvs, err := FindLaunchTemplateVersions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Versions: %s", launchTemplateID, err)
+	}
+
+	// Version descriptions can't be filtered on server-side.
+	if v, ok := d.GetOk("version_description"); ok {
+		var filtered []*ec2.LaunchTemplateVersion
+
+		for _, ltv := range ltvs {
+			if aws.StringValue(ltv.VersionDescription) == v.(string) {
+				filtered = append(filtered, ltv)
+			}
+		}
+
+		ltvs = filtered
+	}
+
+	// If more than one version matches, use the most recent.
+	var ltv *ec2.LaunchTemplateVersion
+
+	for _, v := range ltvs {
+		if v.LaunchTemplateData == nil {
+			continue
+		}
+
+		if ltv == nil || aws.Int64Value(v.VersionNumber) > aws.Int64Value(ltv.VersionNumber) {
+			ltv = v
+		}
+	}
+
+	if ltv == nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Launch Template Version", tfresource.NewEmptyResultError(input)))
+	}
+
+	version := strconv.FormatInt(aws.Int64Value(ltv.VersionNumber), 10)
+
+	d.SetId(fmt.Sprintf("%s:%s", launchTemplateID, version))
+	d.Set("create_time", aws.TimeValue(ltv.CreateTime).Format(time.RFC3339))
+	d.Set("created_by", ltv.CreatedBy)
+	d.Set("default_version", ltv.DefaultVersion)
+	d.Set("launch_template_name", ltv.LaunchTemplateName)
+	d.Set("version", version)
+	d.Set("version_description", ltv.VersionDescription)
+	d.Set("version_number", ltv.VersionNumber)
+
+	if err := flattenResponseLaunchTemplateData(ctx, conn, d, ltv.LaunchTemplateData); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Version (%s): %s", launchTemplateID, version, err)
+	}
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2LaunchTemplateVersionDataSource_latest(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_version.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_latest(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "default_version", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type", dataSourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_version", dataSourceName, "version_number"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "launch_template_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "launch_template_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "description", dataSourceName, "version_description"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_options.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata_options.0.http_tokens", "required"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersionDataSource_default(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_version.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_default(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "default_version", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_version", dataSourceName, "version_number"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type", "t2.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersionDataSource_versionDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_base(rName, "t2.micro", "release=stable"),
+			},
+			{
+				Config: testAccLaunchTemplateVersionDataSourceConfig_versionDescription(rName, "t3.micro", "release=canary", "release=stable"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_number", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_description", "release=stable"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_type", "t2.micro"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchTemplateVersionDataSourceConfig_base(rName, instanceType, description string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  description   = %[3]q
+  instance_type = %[2]q
+
+  metadata_options {
+    http_endpoint = "enabled"
+    http_tokens   = "required"
+  }
+}
+`, rName, instanceType, description)
+}
+
+func testAccLaunchTemplateVersionDataSourceConfig_latest(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionDataSourceConfig_base(rName, "t3.micro", "latest"), `
+data "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  latest             = true
+}
+`)
+}
+
+func testAccLaunchTemplateVersionDataSourceConfig_default(rName string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionDataSourceConfig_base(rName, "t2.micro", "default"), `
+data "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  default            = true
+}
+`)
+}
+
+func testAccLaunchTemplateVersionDataSourceConfig_versionDescription(rName, instanceType, description, selectedDescription string) string {
+	return acctest.ConfigCompose(testAccLaunchTemplateVersionDataSourceConfig_base(rName, instanceType, description), fmt.Sprintf(`
+data "aws_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  version_description = %[1]q
+}
+`, selectedDescription))
+}
@@ -42,6 +42,7 @@ const (
 	errCodeInvalidInstanceID                                 = "InvalidInstanceID"
 	errCodeInvalidInstanceIDNotFound                         = "InvalidInstanceID.NotFound"
 	errCodeInvalidInternetGatewayIDNotFound                  = "InvalidInternetGatewayID.NotFound"
+	errCodeInvalidIPAddressInUse                             = "InvalidIPAddress.InUse"
 	errCodeInvalidIPAMIdNotFound                             = "InvalidIpamId.NotFound"
 	errCodeInvalidIPAMPoolAllocationIdNotFound               = "InvalidIpamPoolAllocationId.NotFound"
 	errCodeInvalidIPAMPoolIdNotFound                         = "InvalidIpamPoolId.NotFound"
@@ -58,6 +59,7 @@ const (
 	errCodeInvalidNetworkInterfaceIDNotFound                 = "InvalidNetworkInterfaceID.NotFound"
 	errCodeInvalidNetworkInsightsAnalysisIdNotFound          = "InvalidNetworkInsightsAnalysisId.NotFound"
 	errCodeInvalidNetworkInsightsPathIdNotFound              = "InvalidNetworkInsightsPathId.NotFound"
+	errCodeInvalidOptInStatus                                = "InvalidOptInStatus"
 	errCodeInvalidParameter                                  = "InvalidParameter"
 	errCodeInvalidParameterCombination                       = "InvalidParameterCombination"
 	errCodeInvalidParameterException                         = "InvalidParameterException"
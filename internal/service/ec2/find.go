@@ -1752,6 +1752,24 @@ func FindNetworkInterfaceSecurityGroup(ctx context.Context, conn *ec2.EC2, netwo
 	}
 }
 
+func FindNetworkInterfaceSecondaryPrivateIPAddress(ctx context.Context, conn *ec2.EC2, networkInterfaceID, privateIP string) (*ec2.NetworkInterfacePrivateIpAddress, error) {
+	networkInterface, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range networkInterface.PrivateIpAddresses {
+		if !aws.BoolValue(v.Primary) && aws.StringValue(v.PrivateIpAddress) == privateIP {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastError: fmt.Errorf("Network Interface (%s) secondary private IPv4 address (%s) not found", networkInterfaceID, privateIP),
+	}
+}
+
 func FindNetworkInsightsAnalysis(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeNetworkInsightsAnalysesInput) (*ec2.NetworkInsightsAnalysis, error) {
 	output, err := FindNetworkInsightsAnalyses(ctx, conn, input)
 
@@ -6885,6 +6903,21 @@ func FindCreateSnapshotCreateVolumePermissionByTwoPartKey(ctx context.Context, c
 	return nil, &resource.NotFoundError{LastRequest: input}
 }
 
+func FindSnapshotCreateVolumePermissionsBySnapshotID(ctx context.Context, conn *ec2.EC2, snapshotID string) ([]*ec2.CreateVolumePermission, error) {
+	input := &ec2.DescribeSnapshotAttributeInput{
+		Attribute:  aws.String(ec2.SnapshotAttributeNameCreateVolumePermission),
+		SnapshotId: aws.String(snapshotID),
+	}
+
+	output, err := FindSnapshotAttribute(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.CreateVolumePermissions, nil
+}
+
 func FindFindSnapshotTierStatuses(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeSnapshotTierStatusInput) ([]*ec2.SnapshotTierStatus, error) {
 	var output []*ec2.SnapshotTierStatus
 
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -132,7 +134,7 @@ func resourceIPAMPoolCIDRCreate(ctx context.Context, d *schema.ResourceData, met
 	cidrBlock := aws.StringValue(output.IpamPoolCidr.Cidr)
 	poolCidrId := aws.StringValue(output.IpamPoolCidr.IpamPoolCidrId)
 
-	ipamPoolCidr, err := WaitIPAMPoolCIDRIdCreated(ctx, conn, poolCidrId, poolID, cidrBlock, d.Timeout(schema.TimeoutDelete))
+	ipamPoolCidr, err := WaitIPAMPoolCIDRIdCreated(ctx, conn, poolCidrId, poolID, cidrBlock, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for IPAM Pool CIDR with ID (%s) create: %s", poolCidrId, err)
@@ -186,24 +188,39 @@ func resourceIPAMPoolCIDRDelete(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	log.Printf("[DEBUG] Deleting IPAM Pool CIDR: %s", d.Id())
-	_, err = conn.DeprovisionIpamPoolCidrWithContext(ctx, &ec2.DeprovisionIpamPoolCidrInput{
-		Cidr:       aws.String(cidrBlock),
-		IpamPoolId: aws.String(poolID),
-	})
+	// Allocations from the CIDR are released asynchronously and the CIDR fails to deprovision until they are gone.
+	_, err = tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, error) {
+			_, err := conn.DeprovisionIpamPoolCidrWithContext(ctx, &ec2.DeprovisionIpamPoolCidrInput{
+				Cidr:       aws.String(cidrBlock),
+				IpamPoolId: aws.String(poolID),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMPoolIdNotFound) {
+				return nil, nil
+			}
+
+			// IncorrectState error can mean: State = "deprovisioned" || State = "pending-deprovision".
+			if err != nil && !tfawserr.ErrCodeEquals(err, errCodeIncorrectState) {
+				return nil, err
+			}
+
+			return WaitIPAMPoolCIDRDeleted(ctx, conn, cidrBlock, poolID, poolCidrId, d.Timeout(schema.TimeoutDelete))
+		},
+		func(err error) (bool, error) {
+			var unexpectedStateErr *resource.UnexpectedStateError
+			if errors.As(err, &unexpectedStateErr) && unexpectedStateErr.State == ec2.IpamPoolCidrStateFailedDeprovision {
+				return true, err
+			}
 
-	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMPoolIdNotFound) {
-		return diags
-	}
+			return false, err
+		},
+	)
 
-	// IncorrectState error can mean: State = "deprovisioned" || State = "pending-deprovision".
-	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeIncorrectState) {
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting IPAM Pool CIDR (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitIPAMPoolCIDRDeleted(ctx, conn, cidrBlock, poolID, poolCidrId, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for IPAM Pool CIDR (%s) delete: %s", d.Id(), err)
-	}
-
 	return diags
 }
 
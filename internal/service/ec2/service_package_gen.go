@@ -247,6 +247,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLaunchTemplate,
 			TypeName: "aws_launch_template",
 		},
+		{
+			Factory:  DataSourceLaunchTemplateVersion,
+			TypeName: "aws_launch_template_version",
+		},
 		{
 			Factory:  DataSourceNATGateway,
 			TypeName: "aws_nat_gateway",
@@ -291,6 +295,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityGroups,
 			TypeName: "aws_security_groups",
 		},
+		{
+			Factory:  DataSourceSnapshotCreateVolumePermissions,
+			TypeName: "aws_snapshot_create_volume_permissions",
+		},
 		{
 			Factory:  DataSourceSubnet,
 			TypeName: "aws_subnet",
@@ -652,6 +660,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceNetworkInterfaceAttachment,
 			TypeName: "aws_network_interface_attachment",
 		},
+		{
+			Factory:  ResourceNetworkInterfacePrivateIPAttachment,
+			TypeName: "aws_network_interface_private_ip_attachment",
+		},
 		{
 			Factory:  ResourceNetworkInterfaceSGAttachment,
 			TypeName: "aws_network_interface_sg_attachment",
@@ -684,6 +696,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSnapshotCreateVolumePermission,
 			TypeName: "aws_snapshot_create_volume_permission",
 		},
+		{
+			Factory:  ResourceSnapshotCreateVolumePermissions,
+			TypeName: "aws_snapshot_create_volume_permissions",
+		},
 		{
 			Factory:  ResourceSpotDataFeedSubscription,
 			TypeName: "aws_spot_datafeed_subscription",
@@ -1217,6 +1217,26 @@ func StatusVPCEndpointServiceStateDeleted(ctx context.Context, conn *ec2.EC2, id
 	}
 }
 
+func StatusVPCEndpointServicePrivateDNSNameState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.PrivateDnsNameConfiguration == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.PrivateDnsNameConfiguration.State), nil
+	}
+}
+
 const (
 	VPCEndpointRouteTableAssociationStatusReady = "ready"
 )
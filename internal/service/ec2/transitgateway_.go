@@ -2,8 +2,11 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -40,14 +43,7 @@ func ResourceTransitGateway() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
-			customdiff.ForceNewIfChange("default_route_table_association", func(_ context.Context, old, new, meta interface{}) bool {
-				// Only changes from disable to enable for feature_set should force a new resource.
-				return old.(string) == ec2.DefaultRouteTableAssociationValueDisable && new.(string) == ec2.DefaultRouteTableAssociationValueEnable
-			}),
-			customdiff.ForceNewIfChange("default_route_table_propagation", func(_ context.Context, old, new, meta interface{}) bool {
-				// Only changes from disable to enable for feature_set should force a new resource.
-				return old.(string) == ec2.DefaultRouteTablePropagationValueDisable && new.(string) == ec2.DefaultRouteTablePropagationValueEnable
-			}),
+			resourceTransitGatewayCIDRBlocksCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -246,11 +242,23 @@ func resourceTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		if d.HasChange("default_route_table_association") {
-			input.Options.DefaultRouteTableAssociation = aws.String(d.Get("default_route_table_association").(string))
+			v := d.Get("default_route_table_association").(string)
+			input.Options.DefaultRouteTableAssociation = aws.String(v)
+
+			// Re-enabling reuses the previous default association route table, if any.
+			if id := d.Get("association_default_route_table_id").(string); v == ec2.DefaultRouteTableAssociationValueEnable && id != "" {
+				input.Options.AssociationDefaultRouteTableId = aws.String(id)
+			}
 		}
 
 		if d.HasChange("default_route_table_propagation") {
-			input.Options.DefaultRouteTablePropagation = aws.String(d.Get("default_route_table_propagation").(string))
+			v := d.Get("default_route_table_propagation").(string)
+			input.Options.DefaultRouteTablePropagation = aws.String(v)
+
+			// Re-enabling reuses the previous default propagation route table, if any.
+			if id := d.Get("propagation_default_route_table_id").(string); v == ec2.DefaultRouteTablePropagationValueEnable && id != "" {
+				input.Options.PropagationDefaultRouteTableId = aws.String(id)
+			}
 		}
 
 		if d.HasChange("description") {
@@ -323,3 +331,85 @@ func resourceTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// resourceTransitGatewayCIDRBlocksCustomizeDiff fails the plan if a CIDR block being removed
+// contains the transit gateway address of a Connect peer.
+func resourceTransitGatewayCIDRBlocksCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("transit_gateway_cidr_blocks") {
+		return nil
+	}
+
+	oRaw, nRaw := diff.GetChange("transit_gateway_cidr_blocks")
+	del := oRaw.(*schema.Set).Difference(nRaw.(*schema.Set))
+
+	if del.Len() == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	connects, err := FindTransitGatewayConnects(ctx, conn, &ec2.DescribeTransitGatewayConnectsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"transit-gateway-id": diff.Id(),
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway (%s) Connect attachments: %w", diff.Id(), err)
+	}
+
+	var attachmentIDs []string
+	for _, v := range connects {
+		switch aws.StringValue(v.State) {
+		case ec2.TransitGatewayAttachmentStateDeleted, ec2.TransitGatewayAttachmentStateDeleting:
+			continue
+		}
+
+		attachmentIDs = append(attachmentIDs, aws.StringValue(v.TransitGatewayAttachmentId))
+	}
+
+	if len(attachmentIDs) == 0 {
+		return nil
+	}
+
+	peers, err := FindTransitGatewayConnectPeers(ctx, conn, &ec2.DescribeTransitGatewayConnectPeersInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("transit-gateway-attachment-id"),
+			Values: aws.StringSlice(attachmentIDs),
+		}},
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway (%s) Connect peers: %w", diff.Id(), err)
+	}
+
+	var inUse []string
+	for _, cidr := range flex.ExpandStringValueSet(del) {
+		_, ipNet, err := net.ParseCIDR(cidr)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range peers {
+			switch aws.StringValue(v.State) {
+			case ec2.TransitGatewayConnectPeerStateDeleted, ec2.TransitGatewayConnectPeerStateDeleting:
+				continue
+			}
+
+			if v.ConnectPeerConfiguration == nil {
+				continue
+			}
+
+			if ip := net.ParseIP(aws.StringValue(v.ConnectPeerConfiguration.TransitGatewayAddress)); ip != nil && ipNet.Contains(ip) {
+				inUse = append(inUse, fmt.Sprintf("%s (Connect attachment %s, peer %s)", cidr, aws.StringValue(v.TransitGatewayAttachmentId), aws.StringValue(v.TransitGatewayConnectPeerId)))
+			}
+		}
+	}
+
+	if len(inUse) > 0 {
+		return fmt.Errorf("cannot remove EC2 Transit Gateway (%s) CIDR blocks in use: %s", diff.Id(), strings.Join(inUse, ", "))
+	}
+
+	return nil
+}
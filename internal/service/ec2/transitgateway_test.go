@@ -45,6 +45,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"AmazonSideASN":               testAccTransitGateway_AmazonSideASN,
 			"AutoAcceptSharedAttachments": testAccTransitGateway_AutoAcceptSharedAttachments,
 			"CidrBlocks":                  testAccTransitGateway_cidrBlocks,
+			"CidrBlocksInUse":             testAccTransitGateway_cidrBlocksInUse,
 			"DefaultRouteTableAssociationAndPropagationDisabled": testAccTransitGateway_DefaultRouteTableAssociationAndPropagationDisabled,
 			"DefaultRouteTableAssociation":                       testAccTransitGateway_DefaultRouteTableAssociation,
 			"DefaultRouteTablePropagation":                       testAccTransitGateway_DefaultRouteTablePropagation,
@@ -330,6 +331,33 @@ func testAccTransitGateway_cidrBlocks(t *testing.T) {
 	})
 }
 
+func testAccTransitGateway_cidrBlocksInUse(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConfig_cidrBlocksConnectPeer(rName, `["10.20.30.0/24", "10.20.31.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_cidr_blocks.#", "2"),
+				),
+			},
+			{
+				Config:      testAccTransitGatewayConfig_cidrBlocksConnectPeer(rName, `["10.20.31.0/24"]`),
+				ExpectError: regexp.MustCompile(`cannot remove EC2 Transit Gateway \(tgw-[0-9a-f]+\) CIDR blocks in use: 10\.20\.30\.0/24 \(Connect attachment tgw-attach-[0-9a-f]+`),
+			},
+		},
+	})
+}
+
 func testAccTransitGateway_DefaultRouteTableAssociationAndPropagationDisabled(t *testing.T) {
 	ctx := acctest.Context(t)
 	var transitGateway1 ec2.TransitGateway
@@ -387,7 +415,7 @@ func testAccTransitGateway_DefaultRouteTableAssociation(t *testing.T) {
 				Config: testAccTransitGatewayConfig_defaultRouteTableAssociation(rName, ec2.DefaultRouteTableAssociationValueEnable),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayExists(ctx, resourceName, &transitGateway2),
-					testAccCheckTransitGatewayRecreated(&transitGateway1, &transitGateway2),
+					testAccCheckTransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
 					resource.TestCheckResourceAttr(resourceName, "default_route_table_association", ec2.DefaultRouteTableAssociationValueEnable),
 				),
 			},
@@ -431,7 +459,7 @@ func testAccTransitGateway_DefaultRouteTablePropagation(t *testing.T) {
 				Config: testAccTransitGatewayConfig_defaultRouteTablePropagation(rName, ec2.DefaultRouteTablePropagationValueEnable),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayExists(ctx, resourceName, &transitGateway2),
-					testAccCheckTransitGatewayRecreated(&transitGateway1, &transitGateway2),
+					testAccCheckTransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
 					resource.TestCheckResourceAttr(resourceName, "default_route_table_propagation", ec2.DefaultRouteTablePropagationValueEnable),
 				),
 			},
@@ -663,16 +691,6 @@ func testAccCheckTransitGatewayNotRecreated(i, j *ec2.TransitGateway) resource.T
 	}
 }
 
-func testAccCheckTransitGatewayRecreated(i, j *ec2.TransitGateway) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if aws.StringValue(i.TransitGatewayId) == aws.StringValue(j.TransitGatewayId) {
-			return errors.New("EC2 Transit Gateway was not recreated")
-		}
-
-		return nil
-	}
-}
-
 func testAccCheckTransitGatewayAssociationDefaultRouteTableAttachmentAssociated(ctx context.Context, transitGateway *ec2.TransitGateway, transitGatewayAttachment interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		transitGatewayRouteTableID := aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
@@ -983,6 +1001,62 @@ resource "aws_ec2_transit_gateway" "test" {
 `, rName)
 }
 
+func testAccTransitGatewayConfig_cidrBlocksConnectPeer(rName, cidrBlocks string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = %[2]s
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_address       = "10.20.30.1"
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+}
+`, rName, cidrBlocks))
+}
+
 func testAccTransitGatewayConfig_cidrBlocks2(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -132,6 +132,11 @@ func ResourceVPCEndpointService() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_private_dns_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -267,6 +272,11 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("allowed_principals", flattenAllowedPrincipals(allowedPrincipals))
 
+	// Default wait_for_private_dns_verification for imported resources and state written before the argument was added.
+	if rawState := d.GetRawState(); !rawState.IsNull() && rawState.GetAttr("wait_for_private_dns_verification").IsNull() {
+		d.Set("wait_for_private_dns_verification", false)
+	}
+
 	return diags
 }
 
@@ -324,6 +334,15 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	// The domain verification TXT record can only be created once the service exists,
+	// and for a changed name only after this update, so verification is only waited for
+	// when this argument is enabled for an unchanged name.
+	if d.Get("wait_for_private_dns_verification").(bool) && d.Get("private_dns_name").(string) != "" && d.HasChange("wait_for_private_dns_verification") && !d.HasChange("private_dns_name") {
+		if err := verifyVPCEndpointServicePrivateDNSName(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying EC2 VPC Endpoint Service (%s) private DNS name: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceVPCEndpointServiceRead(ctx, d, meta)...)
 }
 
@@ -355,6 +374,32 @@ func resourceVPCEndpointServiceDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func verifyVPCEndpointServicePrivateDNSName(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	svcCfg, err := FindVPCEndpointServiceConfigurationByID(ctx, conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if v := svcCfg.PrivateDnsNameConfiguration; v != nil && aws.StringValue(v.State) == ec2.DnsNameStateVerified {
+		return nil
+	}
+
+	_, err = conn.StartVpcEndpointServicePrivateDnsVerificationWithContext(ctx, &ec2.StartVpcEndpointServicePrivateDnsVerificationInput{
+		ServiceId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("starting verification: %w", err)
+	}
+
+	if _, err := WaitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for verification: %w", err)
+	}
+
+	return nil
+}
+
 func flattenAllowedPrincipal(apiObject *ec2.AllowedPrincipal) *string {
 	if apiObject == nil {
 		return nil
@@ -319,6 +319,46 @@ func TestAccVPCEndpointService_privateDNSName(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpointService_privateDNSNameVerification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServiceConfig_privateDNSNameVerification(rName, rootDomain, domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, resourceName, &svcCfg),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_private_dns_verification", "false"),
+				),
+			},
+			{
+				Config: testAccVPCEndpointServiceConfig_privateDNSNameVerification(rName, rootDomain, domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, resourceName, &svcCfg),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.0.state", ec2.DnsNameStateVerified),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_private_dns_verification", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_private_dns_verification"},
+			},
+		},
+	})
+}
+
 func testAccCheckVPCEndpointServiceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
@@ -607,3 +647,32 @@ resource "aws_vpc_endpoint_service" "test" {
 }
 `, rName, dnsName))
 }
+
+func testAccVPCEndpointServiceConfig_privateDNSNameVerification(rName, rootDomain, dnsName string, wait bool) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_networkLoadBalancerBase(rName, 1), fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = false
+  network_load_balancer_arns = aws_lb.test[*].arn
+  private_dns_name           = %[3]q
+
+  wait_for_private_dns_verification = %[4]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = data.aws_route53_zone.test.zone_id
+  name    = "${aws_vpc_endpoint_service.test.private_dns_name_configuration[0].name}.${aws_vpc_endpoint_service.test.private_dns_name}"
+  type    = aws_vpc_endpoint_service.test.private_dns_name_configuration[0].type
+  ttl     = 60
+  records = [aws_vpc_endpoint_service.test.private_dns_name_configuration[0].value]
+}
+`, rName, rootDomain, dnsName, wait))
+}
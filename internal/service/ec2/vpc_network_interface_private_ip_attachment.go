@@ -0,0 +1,186 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_network_interface_private_ip_attachment")
+func ResourceNetworkInterfacePrivateIPAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInterfacePrivateIPAttachmentCreate,
+		ReadWithoutTimeout:   resourceNetworkInterfacePrivateIPAttachmentRead,
+		DeleteWithoutTimeout: resourceNetworkInterfacePrivateIPAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkInterfacePrivateIPAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_reassignment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"private_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfacePrivateIPAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	input := &ec2.AssignPrivateIpAddressesInput{
+		NetworkInterfaceId: aws.String(networkInterfaceID),
+	}
+
+	if v, ok := d.GetOk("allow_reassignment"); ok {
+		input.AllowReassignment = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("private_ip"); ok {
+		input.PrivateIpAddresses = aws.StringSlice([]string{v.(string)})
+	} else {
+		input.SecondaryPrivateIpAddressCount = aws.Int64(1)
+	}
+
+	log.Printf("[DEBUG] Assigning EC2 Network Interface private IPv4 address: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssignPrivateIpAddressesWithContext(ctx, input)
+	}, errCodeIncorrectState)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "assigning EC2 Network Interface (%s) private IPv4 address: %s", networkInterfaceID, err)
+	}
+
+	output := outputRaw.(*ec2.AssignPrivateIpAddressesOutput)
+
+	if len(output.AssignedPrivateIpAddresses) == 0 || output.AssignedPrivateIpAddresses[0] == nil {
+		return sdkdiag.AppendErrorf(diags, "assigning EC2 Network Interface (%s) private IPv4 address: %s", networkInterfaceID, errors.New("empty response"))
+	}
+
+	privateIP := aws.StringValue(output.AssignedPrivateIpAddresses[0].PrivateIpAddress)
+
+	d.SetId(NetworkInterfacePrivateIPAttachmentCreateResourceID(networkInterfaceID, privateIP))
+
+	if _, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindNetworkInterfaceSecondaryPrivateIPAddress(ctx, conn, networkInterfaceID, privateIP)
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Interface (%s) private IPv4 address (%s) assign: %s", networkInterfaceID, privateIP, err)
+	}
+
+	return append(diags, resourceNetworkInterfacePrivateIPAttachmentRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInterfacePrivateIPAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	networkInterfaceID, privateIP, err := NetworkInterfacePrivateIPAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface private IPv4 address (%s): %s", d.Id(), err)
+	}
+
+	// Only this attachment's address is checked, other addresses on the network interface are left to their owners.
+	_, err = FindNetworkInterfaceSecondaryPrivateIPAddress(ctx, conn, networkInterfaceID, privateIP)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Interface private IPv4 address (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface private IPv4 address (%s): %s", d.Id(), err)
+	}
+
+	d.Set("network_interface_id", networkInterfaceID)
+	d.Set("private_ip", privateIP)
+
+	return diags
+}
+
+func resourceNetworkInterfacePrivateIPAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	networkInterfaceID, privateIP, err := NetworkInterfacePrivateIPAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Interface private IPv4 address (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Unassigning EC2 Network Interface private IPv4 address: %s", d.Id())
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.UnassignPrivateIpAddressesWithContext(ctx, &ec2.UnassignPrivateIpAddressesInput{
+			NetworkInterfaceId: aws.String(networkInterfaceID),
+			PrivateIpAddresses: aws.StringSlice([]string{privateIP}),
+		})
+	}, errCodeIncorrectState)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInterfaceIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "unassigning EC2 Network Interface private IPv4 address (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceNetworkInterfacePrivateIPAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := NetworkInterfacePrivateIPAttachmentParseResourceID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("allow_reassignment", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const networkInterfacePrivateIPAttachmentIDSeparator = "_"
+
+func NetworkInterfacePrivateIPAttachmentCreateResourceID(networkInterfaceID, privateIP string) string {
+	parts := []string{networkInterfaceID, privateIP}
+	id := strings.Join(parts, networkInterfacePrivateIPAttachmentIDSeparator)
+
+	return id
+}
+
+func NetworkInterfacePrivateIPAttachmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, networkInterfacePrivateIPAttachmentIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NetworkInterfaceID%[2]sPrivateIP", id, networkInterfacePrivateIPAttachmentIDSeparator)
+}
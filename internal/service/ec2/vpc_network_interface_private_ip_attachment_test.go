@@ -0,0 +1,191 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCNetworkInterfacePrivateIPAttachment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	networkInterfaceResourceName := "aws_network_interface.test"
+	resourceName := "aws_network_interface_private_ip_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfacePrivateIPAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePrivateIPAttachmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePrivateIPAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_reassignment", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", networkInterfaceResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInterfacePrivateIPAttachment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_network_interface_private_ip_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfacePrivateIPAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePrivateIPAttachmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePrivateIPAttachmentExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceNetworkInterfacePrivateIPAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInterfacePrivateIPAttachment_privateIP(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_network_interface_private_ip_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfacePrivateIPAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfacePrivateIPAttachmentConfig_privateIP(rName, "172.16.10.100"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfacePrivateIPAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "private_ip", "172.16.10.100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInterfacePrivateIPAttachmentExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Network Interface Private IP Attachment ID is set: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		_, err := tfec2.FindNetworkInterfaceSecondaryPrivateIPAddress(ctx, conn, rs.Primary.Attributes["network_interface_id"], rs.Primary.Attributes["private_ip"])
+
+		return err
+	}
+}
+
+func testAccCheckNetworkInterfacePrivateIPAttachmentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_network_interface_private_ip_attachment" {
+				continue
+			}
+
+			_, err := tfec2.FindNetworkInterfaceSecondaryPrivateIPAddress(ctx, conn, rs.Primary.Attributes["network_interface_id"], rs.Primary.Attributes["private_ip"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Network Interface Private IP Attachment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCNetworkInterfacePrivateIPAttachmentConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "172.16.10.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [private_ips, private_ips_count, private_ip_list]
+  }
+}
+`, rName)
+}
+
+func testAccVPCNetworkInterfacePrivateIPAttachmentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfacePrivateIPAttachmentConfig_base(rName), `
+resource "aws_network_interface_private_ip_attachment" "test" {
+  network_interface_id = aws_network_interface.test.id
+}
+`)
+}
+
+func testAccVPCNetworkInterfacePrivateIPAttachmentConfig_privateIP(rName, privateIP string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInterfacePrivateIPAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_network_interface_private_ip_attachment" "test" {
+  network_interface_id = aws_network_interface.test.id
+  private_ip           = %[1]q
+}
+`, privateIP))
+}
@@ -21,6 +21,7 @@ func ResourceNetworkInterfaceSGAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceSGAttachmentCreate,
 		ReadWithoutTimeout:   resourceNetworkInterfaceSGAttachmentRead,
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourceNetworkInterfaceSGAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceNetworkInterfaceSGAttachmentImport,
@@ -37,6 +38,11 @@ func ResourceNetworkInterfaceSGAttachment() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"wait_for_propagation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -51,35 +57,39 @@ func resourceNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.R
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+	// The network interface may be owned by another service that modifies its security groups concurrently,
+	// so read the current groups and modify them together, retrying while the interface is being changed.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s): %s", networkInterfaceID, err)
-	}
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Network Interface (%s): %w", networkInterfaceID, err)
+		}
 
-	groupIDs := []string{sgID}
+		groupIDs := []string{sgID}
 
-	for _, group := range eni.Groups {
-		if group == nil {
-			continue
-		}
+		for _, group := range eni.Groups {
+			if group == nil {
+				continue
+			}
 
-		groupID := aws.StringValue(group.GroupId)
+			groupID := aws.StringValue(group.GroupId)
 
-		if groupID == sgID {
-			return sdkdiag.AppendErrorf(diags, "EC2 Security Group (%s) already attached to EC2 Network Interface (%s)", sgID, networkInterfaceID)
-		}
+			if groupID == sgID {
+				return nil, fmt.Errorf("EC2 Security Group (%s) already attached to EC2 Network Interface (%s)", sgID, networkInterfaceID)
+			}
 
-		groupIDs = append(groupIDs, groupID)
-	}
+			groupIDs = append(groupIDs, groupID)
+		}
 
-	input := &ec2.ModifyNetworkInterfaceAttributeInput{
-		NetworkInterfaceId: aws.String(networkInterfaceID),
-		Groups:             aws.StringSlice(groupIDs),
-	}
+		input := &ec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: aws.String(networkInterfaceID),
+			Groups:             aws.StringSlice(groupIDs),
+		}
 
-	log.Printf("[INFO] Modifying EC2 Network Interface: %s", input)
-	_, err = conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+		log.Printf("[INFO] Modifying EC2 Network Interface: %s", input)
+		return conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+	}, errCodeIncorrectState)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s): %s", networkInterfaceID, err)
@@ -87,6 +97,16 @@ func resourceNetworkInterfaceSGAttachmentCreate(ctx context.Context, d *schema.R
 
 	d.SetId(fmt.Sprintf("%s_%s", sgID, networkInterfaceID))
 
+	if !d.Get("wait_for_propagation").(bool) {
+		return diags
+	}
+
+	if _, err := tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindNetworkInterfaceSecurityGroup(ctx, conn, networkInterfaceID, sgID)
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Interface (%s) Security Group (%s) Attachment create: %s", networkInterfaceID, sgID, err)
+	}
+
 	return append(diags, resourceNetworkInterfaceSGAttachmentRead(ctx, d, meta)...)
 }
 
@@ -96,9 +116,8 @@ func resourceNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.Res
 
 	networkInterfaceID := d.Get("network_interface_id").(string)
 	sgID := d.Get("security_group_id").(string)
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
-		return FindNetworkInterfaceSecurityGroup(ctx, conn, networkInterfaceID, sgID)
-	}, d.IsNewResource())
+	// Only this attachment's security group is checked, other groups on the network interface are left to their owners.
+	groupIdentifier, err := FindNetworkInterfaceSecurityGroup(ctx, conn, networkInterfaceID, sgID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Network Interface (%s) Security Group (%s) Attachment not found, removing from state", networkInterfaceID, sgID)
@@ -110,8 +129,6 @@ func resourceNetworkInterfaceSGAttachmentRead(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s) Security Group (%s) Attachment: %s", networkInterfaceID, sgID, err)
 	}
 
-	groupIdentifier := outputRaw.(*ec2.GroupIdentifier)
-
 	d.Set("network_interface_id", networkInterfaceID)
 	d.Set("security_group_id", groupIdentifier.GroupId)
 
@@ -128,39 +145,41 @@ func resourceNetworkInterfaceSGAttachmentDelete(ctx context.Context, d *schema.R
 	conns.GlobalMutexKV.Lock(mutexKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKey)
 
-	eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+		eni, err := FindNetworkInterfaceByID(ctx, conn, networkInterfaceID)
 
-	if tfresource.NotFound(err) {
-		return diags
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s): %s", networkInterfaceID, err)
-	}
+		groupIDs := []string{}
 
-	groupIDs := []string{}
+		for _, group := range eni.Groups {
+			if group == nil {
+				continue
+			}
 
-	for _, group := range eni.Groups {
-		if group == nil {
-			continue
-		}
+			groupID := aws.StringValue(group.GroupId)
 
-		groupID := aws.StringValue(group.GroupId)
+			if groupID == sgID {
+				continue
+			}
 
-		if groupID == sgID {
-			continue
+			groupIDs = append(groupIDs, groupID)
 		}
 
-		groupIDs = append(groupIDs, groupID)
-	}
+		input := &ec2.ModifyNetworkInterfaceAttributeInput{
+			NetworkInterfaceId: aws.String(networkInterfaceID),
+			Groups:             aws.StringSlice(groupIDs),
+		}
 
-	input := &ec2.ModifyNetworkInterfaceAttributeInput{
-		NetworkInterfaceId: aws.String(networkInterfaceID),
-		Groups:             aws.StringSlice(groupIDs),
-	}
+		log.Printf("[INFO] Modifying EC2 Network Interface: %s", input)
+		return conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+	}, errCodeIncorrectState)
 
-	log.Printf("[INFO] Modifying EC2 Network Interface: %s", input)
-	_, err = conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+	if tfresource.NotFound(err) {
+		return diags
+	}
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInterfaceIDNotFound) {
 		return diags
@@ -209,6 +228,7 @@ func resourceNetworkInterfaceSGAttachmentImport(ctx context.Context, d *schema.R
 
 	d.SetId(associationID)
 	d.Set("network_interface_id", networkInterfaceID)
+	d.Set("wait_for_propagation", true)
 
 	return []*schema.ResourceData{d}, nil
 }
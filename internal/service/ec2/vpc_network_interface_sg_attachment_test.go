@@ -69,6 +69,33 @@ func TestAccVPCNetworkInterfaceSgAttachment_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterfaceSgAttachment_noWaitForPropagation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_network_interface_sg_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInterfaceSGAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceSGAttachmentConfig_noWaitForPropagation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "wait_for_propagation", "false"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInterfaceSGAttachmentConfig_noWaitForPropagation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInterfaceSGAttachmentExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInterfaceSgAttachment_instance(t *testing.T) {
 	ctx := acctest.Context(t)
 	instanceResourceName := "aws_instance.test"
@@ -222,6 +249,50 @@ resource "aws_network_interface_sg_attachment" "test" {
 `, rName)
 }
 
+func testAccVPCNetworkInterfaceSGAttachmentConfig_noWaitForPropagation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "172.16.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "172.16.10.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface_sg_attachment" "test" {
+  network_interface_id = aws_network_interface.test.id
+  security_group_id    = aws_security_group.test.id
+  wait_for_propagation = false
+}
+`, rName)
+}
+
 func testAccVPCNetworkInterfaceSGAttachmentConfig_viaInstance(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -36,12 +36,12 @@ const (
 	AvailabilityZoneGroupOptInStatusTimeout = 10 * time.Minute
 )
 
-func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name string) (*ec2.AvailabilityZone, error) {
+func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name string, timeout time.Duration) (*ec2.AvailabilityZone, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.AvailabilityZoneOptInStatusNotOptedIn},
 		Target:  []string{ec2.AvailabilityZoneOptInStatusOptedIn},
 		Refresh: StatusAvailabilityZoneGroupOptInStatus(ctx, conn, name),
-		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -53,12 +53,12 @@ func WaitAvailabilityZoneGroupOptedIn(ctx context.Context, conn *ec2.EC2, name s
 	return nil, err
 }
 
-func WaitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.EC2, name string) (*ec2.AvailabilityZone, error) {
+func WaitAvailabilityZoneGroupNotOptedIn(ctx context.Context, conn *ec2.EC2, name string, timeout time.Duration) (*ec2.AvailabilityZone, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.AvailabilityZoneOptInStatusOptedIn},
 		Target:  []string{ec2.AvailabilityZoneOptInStatusNotOptedIn},
 		Refresh: StatusAvailabilityZoneGroupOptInStatus(ctx, conn, name),
-		Timeout: AvailabilityZoneGroupOptInStatusTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -2647,6 +2647,25 @@ func WaitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.EC2, id stri
 	return nil, err
 }
 
+func WaitVPCEndpointServicePrivateDNSNameVerified(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.DnsNameStatePendingVerification},
+		Target:     []string{ec2.DnsNameStateVerified},
+		Refresh:    StatusVPCEndpointServicePrivateDNSNameState(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.ServiceConfiguration); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitVPCEndpointServiceDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ServiceConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
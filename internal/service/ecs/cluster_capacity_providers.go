@@ -2,7 +2,10 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -13,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_ecs_cluster_capacity_providers")
@@ -27,6 +31,8 @@ func ResourceClusterCapacityProviders() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffClusterCapacityProvidersStrategy,
+
 		Schema: map[string]*schema.Schema{
 			"capacity_providers": {
 				Type:     schema.TypeSet,
@@ -76,6 +82,17 @@ func resourceClusterCapacityProvidersPut(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).ECSConn()
 
 	clusterName := d.Get("cluster_name").(string)
+
+	// Fail before updating the cluster if services still use a capacity provider that is being removed.
+	if !d.IsNewResource() && d.HasChange("capacity_providers") {
+		o, n := d.GetChange("capacity_providers")
+		removed := flex.ExpandStringValueSet(o.(*schema.Set).Difference(n.(*schema.Set)))
+
+		if err := checkCapacityProvidersNotInUse(ctx, conn, clusterName, removed); err != nil {
+			return diag.Errorf("updating ECS Cluster Capacity Providers (%s): %s", clusterName, err)
+		}
+	}
+
 	input := &ecs.PutClusterCapacityProvidersInput{
 		CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
 		Cluster:                         aws.String(clusterName),
@@ -172,3 +189,62 @@ func retryClusterCapacityProvidersPut(ctx context.Context, conn *ecs.ECS, input
 
 	return err
 }
+
+// customizeDiffClusterCapacityProvidersStrategy checks that at most one capacity provider in the default strategy has a base defined.
+func customizeDiffClusterCapacityProvidersStrategy(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	var withBase []string
+
+	for _, tfMapRaw := range d.Get("default_capacity_provider_strategy").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["base"].(int); ok && v > 0 {
+			withBase = append(withBase, tfMap["capacity_provider"].(string))
+		}
+	}
+
+	if len(withBase) > 1 {
+		sort.Strings(withBase)
+
+		return fmt.Errorf("only one capacity provider in default_capacity_provider_strategy can have a base defined, got: %s", strings.Join(withBase, ", "))
+	}
+
+	return nil
+}
+
+// checkCapacityProvidersNotInUse returns an error listing the cluster's services whose capacity provider strategy
+// references any of the specified capacity providers.
+func checkCapacityProvidersNotInUse(ctx context.Context, conn *ecs.ECS, cluster string, capacityProviders []string) error {
+	if len(capacityProviders) == 0 {
+		return nil
+	}
+
+	services, err := findServicesByCluster(ctx, conn, cluster)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing services: %w", err)
+	}
+
+	var inUse []string
+
+	for _, service := range services {
+		for _, v := range service.CapacityProviderStrategy {
+			if name := aws.StringValue(v.CapacityProvider); slices.Contains(capacityProviders, name) {
+				inUse = append(inUse, fmt.Sprintf("%s (%s)", aws.StringValue(service.ServiceName), name))
+			}
+		}
+	}
+
+	if len(inUse) > 0 {
+		return fmt.Errorf("capacity providers being removed are still used by services: %s", strings.Join(inUse, ", "))
+	}
+
+	return nil
+}
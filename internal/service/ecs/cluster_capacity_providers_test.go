@@ -2,6 +2,7 @@ package ecs_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -297,6 +298,51 @@ func TestAccECSClusterCapacityProviders_Update_defaultStrategy(t *testing.T) {
 	})
 }
 
+func TestAccECSClusterCapacityProviders_Update_capacityProviderInUse(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_inUse(rName, `["FARGATE", "FARGATE_SPOT"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+				),
+			},
+			{
+				Config:      testAccClusterCapacityProvidersConfig_inUse(rName, `["FARGATE"]`),
+				ExpectError: regexp.MustCompile(`capacity providers being removed are still used by services: ` + rName + ` \(FARGATE_SPOT\)`),
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_defaultStrategyMultipleBases(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterCapacityProvidersConfig_defaultProviderStrategyMultipleBases(rName),
+				ExpectError: regexp.MustCompile(`only one capacity provider in default_capacity_provider_strategy can have a base defined`),
+			},
+		},
+	})
+}
+
 func testAccClusterCapacityProvidersConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -455,6 +501,78 @@ resource "aws_ecs_cluster_capacity_providers" "test" {
 `, rName)
 }
 
+func testAccClusterCapacityProvidersConfig_defaultProviderStrategyMultipleBases(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 50
+    capacity_provider = "FARGATE"
+  }
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 50
+    capacity_provider = "FARGATE_SPOT"
+  }
+}
+`, rName)
+}
+
+func testAccClusterCapacityProvidersConfig_inUse(rName, capacityProviders string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = %[2]s
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "nginx:latest"
+    essential = true
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+
+  capacity_provider_strategy {
+    capacity_provider = "FARGATE_SPOT"
+    weight            = 1
+  }
+
+  network_configuration {
+    subnets = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_ecs_cluster_capacity_providers.test]
+}
+`, rName, capacityProviders))
+}
+
 func testAccClusterCapacityProvidersConfig_destroyBefore(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ami" "test" {
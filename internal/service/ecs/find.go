@@ -205,3 +205,55 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func findServicesByCluster(ctx context.Context, conn *ecs.ECS, cluster string) ([]*ecs.Service, error) {
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(cluster),
+	}
+	var arns []*string
+
+	err := conn.ListServicesPagesWithContext(ctx, input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		arns = append(arns, page.ServiceArns...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []*ecs.Service
+
+	// DescribeServices accepts at most 10 services per call.
+	const batchSize = 10
+	for i := 0; i < len(arns); i += batchSize {
+		j := i + batchSize
+		if j > len(arns) {
+			j = len(arns)
+		}
+
+		page, err := conn.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: arns[i:j],
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Services...)
+	}
+
+	return output, nil
+}
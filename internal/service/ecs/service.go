@@ -908,7 +908,12 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange("propagate_tags") {
-			input.PropagateTags = aws.String(d.Get("propagate_tags").(string))
+			// Removing the argument turns off tag propagation.
+			if v, ok := d.GetOk("propagate_tags"); ok {
+				input.PropagateTags = aws.String(v.(string))
+			} else {
+				input.PropagateTags = aws.String(ecs.PropagateTagsNone)
+			}
 		}
 
 		if d.HasChange("service_connect_configuration") {
@@ -0,0 +1,129 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_ecs_service_deployment")
+func ResourceServiceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceDeploymentCreate,
+		ReadWithoutTimeout:   resourceServiceDeploymentRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_steady_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceServiceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn()
+
+	cluster, service := d.Get("cluster").(string), d.Get("service").(string)
+	input := &ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: aws.Bool(true),
+		Service:            aws.String(service),
+	}
+
+	log.Printf("[DEBUG] Forcing new ECS Service (%s) deployment: %s", service, input)
+	output, err := conn.UpdateServiceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "forcing new ECS Service (%s) deployment: %s", service, err)
+	}
+
+	var deploymentID string
+	for _, v := range output.Service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			deploymentID = aws.StringValue(v.Id)
+			break
+		}
+	}
+
+	if deploymentID == "" {
+		return sdkdiag.AppendErrorf(diags, "forcing new ECS Service (%s) deployment: primary deployment not found", service)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, service, deploymentID))
+	d.Set("deployment_id", deploymentID)
+
+	if d.Get("wait_for_steady_state").(bool) {
+		if _, err := waitServiceStable(ctx, conn, service, cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) deployment (%s) to reach steady state: %s", service, deploymentID, err)
+		}
+	}
+
+	return append(diags, resourceServiceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceServiceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn()
+
+	cluster, service := d.Get("cluster").(string), d.Get("service").(string)
+	output, err := FindServiceNoTagsByID(ctx, conn, service, cluster)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Service (%s) not found, removing deployment (%s) from state", service, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Service (%s) deployment (%s): %s", service, d.Id(), err)
+	}
+
+	// A deleted service remains visible with status INACTIVE for some time.
+	if status := aws.StringValue(output.Status); !d.IsNewResource() && status == serviceStatusInactive {
+		log.Printf("[WARN] ECS Service (%s) is %s, removing deployment (%s) from state", service, status, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	// The deployment itself is not read back: once superseded it no longer appears in the
+	// service's deployments and its completion must not trigger another forced deployment.
+	return diags
+}
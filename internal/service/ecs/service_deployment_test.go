@@ -0,0 +1,82 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECSServiceDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var first, second string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDeploymentID(resourceName, &first),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster", "aws_ecs_cluster.default", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service", "aws_ecs_service.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.redeploy", "1"),
+				),
+			},
+			{
+				Config: testAccServiceDeploymentConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceDeploymentID(resourceName, &second),
+					func(*terraform.State) error {
+						if first == second {
+							return fmt.Errorf("expected a new deployment, got %s again", first)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceDeploymentID(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		id := rs.Primary.Attributes["deployment_id"]
+		if id == "" {
+			return fmt.Errorf("%s: deployment_id not set", n)
+		}
+
+		*v = id
+
+		return nil
+	}
+}
+
+func testAccServiceDeploymentConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ecs_service_deployment" "test" {
+  cluster = aws_ecs_cluster.default.id
+  service = aws_ecs_service.test.name
+
+  triggers = {
+    redeploy = %[1]q
+  }
+}
+`, trigger))
+}
@@ -66,6 +66,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceService,
 			TypeName: "aws_ecs_service",
 		},
+		{
+			Factory:  ResourceServiceDeployment,
+			TypeName: "aws_ecs_service_deployment",
+		},
 		{
 			Factory:  ResourceTag,
 			TypeName: "aws_ecs_tag",
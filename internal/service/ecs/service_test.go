@@ -1423,7 +1423,7 @@ func TestAccECSService_Tags_managed(t *testing.T) {
 
 func TestAccECSService_Tags_propagate(t *testing.T) {
 	ctx := acctest.Context(t)
-	var first, second, third ecs.Service
+	var first, second, third, fourth ecs.Service
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ecs_service.test"
 
@@ -1442,16 +1442,27 @@ func TestAccECSService_Tags_propagate(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccServiceConfig_propagateTags(rName, "TASK_DEFINITION"),
+				Config: testAccServiceConfig_propagateTags(rName, "NONE"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &second),
-					resource.TestCheckResourceAttr(resourceName, "propagate_tags", ecs.PropagateTagsTaskDefinition),
+					testAccCheckServiceNotRecreated(&first, &second),
+					resource.TestCheckResourceAttr(resourceName, "propagate_tags", ecs.PropagateTagsNone),
 				),
 			},
 			{
-				Config: testAccServiceConfig_propagateTags(rName, "NONE"),
+				Config: testAccServiceConfig_propagateTags(rName, "TASK_DEFINITION"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &third),
+					testAccCheckServiceNotRecreated(&second, &third),
+					resource.TestCheckResourceAttr(resourceName, "propagate_tags", ecs.PropagateTagsTaskDefinition),
+				),
+			},
+			{
+				Config: testAccServiceConfig_propagateTagsRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &fourth),
+					testAccCheckServiceNotRecreated(&third, &fourth),
+					resource.TestCheckResourceAttr(resourceName, "enable_ecs_managed_tags", "false"),
 					resource.TestCheckResourceAttr(resourceName, "propagate_tags", ecs.PropagateTagsNone),
 				),
 			},
@@ -3978,6 +3989,45 @@ resource "aws_ecs_service" "test" {
 `, rName, propagate)
 }
 
+func testAccServiceConfig_propagateTagsRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+
+  tags = {
+    tag-key = "task-def"
+  }
+}
+
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 0
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+
+  tags = {
+    tag-key = "service"
+  }
+}
+`, rName)
+}
+
 func testAccServiceConfig_replicaSchedulingStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "default" {
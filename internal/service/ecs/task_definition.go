@@ -67,10 +67,277 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"depends_on": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+									},
+									"container_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"environment": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"essential": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"health_check": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"interval": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(5, 300),
+									},
+									"retries": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"start_period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 300),
+									},
+									"timeout": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(2, 120),
+									},
+								},
+							},
+						},
+						"image": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_options": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value_from": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"memory_reservation": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"mount_points": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_path": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"read_only": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"source_volume": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port_mappings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									// Defaults to container_port with the awsvpc network mode.
+									"host_port": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsPortNumberOrZero,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Default:      ecs.TransportProtocolTcp,
+										ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+									},
+								},
+							},
+						},
+						"secrets": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"value_from": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"ulimits": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hard_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+									},
+									"soft_limit": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -443,10 +710,17 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+	var definitions []*ecs.ContainerDefinition
+
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandTaskDefinitionContainerDefinitions(v.([]interface{}))
+	} else {
+		var err error
+
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
 	}
 
 	input := ecs.RegisterTaskDefinitionInput{
@@ -623,6 +897,10 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("container_definition", flattenTaskDefinitionContainerDefinitions(taskDefinition.ContainerDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
@@ -1229,6 +1507,436 @@ func expandContainerDefinitions(rawDefinitions string) ([]*ecs.ContainerDefiniti
 	return definitions, nil
 }
 
+func expandTaskDefinitionContainerDefinitions(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandContainerDefinitionDependsOn(v)
+		}
+
+		if v, ok := tfMap["environment"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Environment = expandContainerDefinitionEnvironment(v.List())
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerDefinitionHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandContainerDefinitionLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_points"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandContainerDefinitionMountPoints(v)
+		}
+
+		if v, ok := tfMap["port_mappings"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PortMappings = expandContainerDefinitionPortMappings(v)
+		}
+
+		if v, ok := tfMap["secrets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandContainerDefinitionSecrets(v.List())
+		}
+
+		if v, ok := tfMap["ulimits"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Ulimits = expandContainerDefinitionUlimits(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionDependsOn(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionEnvironment(tfList []interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_options"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecretOptions = expandContainerDefinitionSecrets(v.List())
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionMountPoints(tfList []interface{}) []*ecs.MountPoint {
+	var apiObjects []*ecs.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+			Protocol:      aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionUlimits(tfList []interface{}) []*ecs.Ulimit {
+	var apiObjects []*ecs.Ulimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Ulimit{
+			HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+			Name:      aws.String(tfMap["name"].(string)),
+			SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTaskDefinitionContainerDefinitions(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"cpu":                aws.Int64Value(apiObject.Cpu),
+			"essential":          aws.BoolValue(apiObject.Essential),
+			"image":              aws.StringValue(apiObject.Image),
+			"memory":             aws.Int64Value(apiObject.Memory),
+			"memory_reservation": aws.Int64Value(apiObject.MemoryReservation),
+			"name":               aws.StringValue(apiObject.Name),
+		}
+
+		// Essential defaults to true when not specified.
+		if apiObject.Essential == nil {
+			tfMap["essential"] = true
+		}
+
+		if v := apiObject.DependsOn; len(v) > 0 {
+			tfMap["depends_on"] = flattenContainerDefinitionDependsOn(v)
+		}
+
+		if v := apiObject.Environment; len(v) > 0 {
+			tfMap["environment"] = flattenContainerDefinitionEnvironment(v)
+		}
+
+		if v := apiObject.HealthCheck; v != nil {
+			tfMap["health_check"] = []interface{}{flattenContainerDefinitionHealthCheck(v)}
+		}
+
+		if v := apiObject.LogConfiguration; v != nil {
+			tfMap["log_configuration"] = []interface{}{flattenContainerDefinitionLogConfiguration(v)}
+		}
+
+		if v := apiObject.MountPoints; len(v) > 0 {
+			tfMap["mount_points"] = flattenContainerDefinitionMountPoints(v)
+		}
+
+		if v := apiObject.PortMappings; len(v) > 0 {
+			tfMap["port_mappings"] = flattenContainerDefinitionPortMappings(v)
+		}
+
+		if v := apiObject.Secrets; len(v) > 0 {
+			tfMap["secrets"] = flattenContainerDefinitionSecrets(v)
+		}
+
+		if v := apiObject.Ulimits; len(v) > 0 {
+			tfMap["ulimits"] = flattenContainerDefinitionUlimits(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionDependsOn(apiObjects []*ecs.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionEnvironment(apiObjects []*ecs.KeyValuePair) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionHealthCheck(apiObject *ecs.HealthCheck) map[string]interface{} {
+	return map[string]interface{}{
+		"command":      flex.FlattenStringList(apiObject.Command),
+		"interval":     aws.Int64Value(apiObject.Interval),
+		"retries":      aws.Int64Value(apiObject.Retries),
+		"start_period": aws.Int64Value(apiObject.StartPeriod),
+		"timeout":      aws.Int64Value(apiObject.Timeout),
+	}
+}
+
+func flattenContainerDefinitionLogConfiguration(apiObject *ecs.LogConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"log_driver": aws.StringValue(apiObject.LogDriver),
+		"options":    aws.StringValueMap(apiObject.Options),
+	}
+
+	if v := apiObject.SecretOptions; len(v) > 0 {
+		tfMap["secret_options"] = flattenContainerDefinitionSecrets(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerDefinitionMountPoints(apiObjects []*ecs.MountPoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.StringValue(apiObject.ContainerPath),
+			"read_only":      aws.BoolValue(apiObject.ReadOnly),
+			"source_volume":  aws.StringValue(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"container_port": aws.Int64Value(apiObject.ContainerPort),
+			"host_port":      aws.Int64Value(apiObject.HostPort),
+			"protocol":       aws.StringValue(apiObject.Protocol),
+		}
+
+		if apiObject.Protocol == nil {
+			tfMap["protocol"] = ecs.TransportProtocolTcp
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionUlimits(apiObjects []*ecs.Ulimit) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit": aws.Int64Value(apiObject.HardLimit),
+			"name":       aws.StringValue(apiObject.Name),
+			"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+		})
+	}
+
+	return tfList
+}
+
 func expandTaskDefinitionEphemeralStorage(config []interface{}) *ecs.EphemeralStorage {
 	configMap := config[0].(map[string]interface{})
 
@@ -103,6 +103,55 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mappings.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mappings.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container_definition.0.environment.*", map[string]string{
+						"name":  "PORT",
+						"value": "80",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.ulimits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -1400,6 +1449,58 @@ TASK_DEFINITION
 `, rName)
 }
 
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 10
+    memory = 128
+
+    port_mappings {
+      container_port = 80
+      host_port      = 8080
+    }
+
+    environment {
+      name  = "PORT"
+      value = "80"
+    }
+
+    environment {
+      name  = "MODE"
+      value = "production"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    depends_on {
+      container_name = "init"
+      condition      = "COMPLETE"
+    }
+
+    ulimits {
+      name       = "nofile"
+      soft_limit = 1024
+      hard_limit = 4096
+    }
+  }
+
+  container_definition {
+    name      = "init"
+    image     = "busybox:latest"
+    memory    = 32
+    essential = false
+  }
+}
+`, rName)
+}
+
 func testAccTaskDefinitionConfig_updatedVolume(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
@@ -2,11 +2,14 @@ package efs
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -17,6 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	fileSystemPolicyPutTimeout = 10 * time.Minute
+)
+
 // @SDKResource("aws_efs_file_system_policy")
 func ResourceFileSystemPolicy() *schema.Resource {
 	return &schema.Resource{
@@ -72,8 +79,10 @@ func resourceFileSystemPolicyPut(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	log.Printf("[DEBUG] Putting EFS File System Policy: %s", input)
-
-	_, err = conn.PutFileSystemPolicyWithContext(ctx, input)
+	// The file system rejects policy changes while mount targets are being created or deleted.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, fileSystemPolicyPutTimeout, func() (interface{}, error) {
+		return conn.PutFileSystemPolicyWithContext(ctx, input)
+	}, efs.ErrCodeIncorrectFileSystemLifeCycleState, efs.ErrCodeTooManyRequests)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "putting EFS File System Policy (%s): %s", fsID, err)
@@ -102,7 +111,16 @@ func resourceFileSystemPolicyRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("file_system_id", output.FileSystemId)
 
-	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(output.Policy))
+	policy := aws.StringValue(output.Policy)
+
+	// EFS adds the file system ARN as the Resource of any statement that doesn't specify one.
+	if v, err := removeFileSystemPolicyInjectedResources(d.Get("policy").(string), policy); err == nil {
+		if equivalent, err := awspolicy.PoliciesAreEquivalent(d.Get("policy").(string), v); err == nil && equivalent {
+			policy = v
+		}
+	}
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), policy)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
@@ -138,3 +156,61 @@ func resourceFileSystemPolicyDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// removeFileSystemPolicyInjectedResources returns the policy read from EFS with the Resource
+// element removed from each statement whose configured counterpart has neither Resource nor NotResource.
+func removeFileSystemPolicyInjectedResources(configured, policy string) (string, error) {
+	var configuredDocument, document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(configured), &configuredDocument); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", err
+	}
+
+	configuredStatements := fileSystemPolicyStatements(configuredDocument)
+	statements := fileSystemPolicyStatements(document)
+
+	if len(configuredStatements) != len(statements) {
+		return policy, nil
+	}
+
+	for i, v := range configuredStatements {
+		if _, ok := v["Resource"]; ok {
+			continue
+		}
+
+		if _, ok := v["NotResource"]; ok {
+			continue
+		}
+
+		delete(statements[i], "Resource")
+	}
+
+	output, err := json.Marshal(document)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+func fileSystemPolicyStatements(document map[string]interface{}) []map[string]interface{} {
+	var statements []map[string]interface{}
+
+	switch v := document["Statement"].(type) {
+	case map[string]interface{}:
+		statements = append(statements, v)
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				statements = append(statements, v)
+			}
+		}
+	}
+
+	return statements
+}
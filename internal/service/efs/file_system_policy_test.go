@@ -107,6 +107,40 @@ func TestAccEFSFileSystemPolicy_policyBypass(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "true"),
 				),
 			},
+			{
+				Config: testAccFileSystemPolicyConfig_bypass(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemPolicyExists(ctx, resourceName, &desc),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEFSFileSystemPolicy_mountTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var desc efs.DescribeFileSystemPolicyOutput
+	resourceName := "aws_efs_file_system_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, efs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFileSystemPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileSystemPolicyConfig_mountTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemPolicyExists(ctx, resourceName, &desc),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				Config:   testAccFileSystemPolicyConfig_mountTargets(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -450,3 +484,46 @@ data "aws_iam_policy_document" "test" {
 }
 `, rName)
 }
+
+func testAccFileSystemPolicyConfig_mountTargets(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_mount_target" "test" {
+  count = 2
+
+  file_system_id = aws_efs_file_system.test.id
+  subnet_id      = aws_subnet.test[count.index].id
+}
+
+# The policy omits Resource, which EFS fills in with the file system ARN.
+resource "aws_efs_file_system_policy" "test" {
+  file_system_id = aws_efs_file_system.test.id
+
+  policy = <<POLICY
+{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Effect": "Allow",
+            "Principal": {
+                "AWS": "*"
+            },
+            "Action": [
+                "elasticfilesystem:ClientMount",
+                "elasticfilesystem:ClientWrite"
+            ],
+            "Condition": {
+                "Bool": {
+                    "aws:SecureTransport": "true"
+                }
+            }
+        }
+    ]
+}
+POLICY
+}
+`, rName))
+}
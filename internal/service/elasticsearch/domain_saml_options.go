@@ -3,6 +3,7 @@ package elasticsearch
 import (
 	"context"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_elasticsearch_domain_saml_options")
@@ -36,6 +38,22 @@ func ResourceDomainSAMLOptions() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"change_progress_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"change_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"domain_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -137,6 +155,10 @@ func resourceDomainSAMLOptionsRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "setting saml_options for Elasticsearch Configuration: %s", err)
 	}
 
+	if err := d.Set("change_progress_details", flattenChangeProgressDetails(ds.ChangeProgressDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting change_progress_details: %s", err)
+	}
+
 	return diags
 }
 
@@ -161,7 +183,10 @@ func resourceDomainSAMLOptionsPut(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(domainName)
 
-	if err := waitForDomainUpdate(ctx, conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	// Changes limited to dynamic SAML options don't trigger a blue/green deployment.
+	if !d.IsNewResource() && domainSAMLOptionsChangeIsDynamic(d) {
+		log.Printf("[DEBUG] Elasticsearch Domain SAML Options (%s) change is dynamic, not waiting for domain update", d.Id())
+	} else if err := waitForDomainUpdate(ctx, conn, d.Get("domain_name").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting Elasticsearch Domain SAML Options (%s): waiting for completion: %s", d.Id(), err)
 	}
 
@@ -192,3 +217,55 @@ func resourceDomainSAMLOptionsDelete(ctx context.Context, d *schema.ResourceData
 
 	return diags
 }
+
+// domainSAMLOptionsDynamicKeys are the SAML options that are applied without a blue/green deployment.
+var domainSAMLOptionsDynamicKeys = []string{"roles_key", "session_timeout_minutes", "subject_key"}
+
+// domainSAMLOptionsChangeIsDynamic returns whether an enabled SAML configuration's changes are limited to dynamic options.
+func domainSAMLOptionsChangeIsDynamic(d *schema.ResourceData) bool {
+	o, n := d.GetChange("saml_options")
+
+	return samlOptionsChangeIsDynamic(o.([]interface{}), n.([]interface{}))
+}
+
+func samlOptionsChangeIsDynamic(os, ns []interface{}) bool {
+	if len(os) == 0 || os[0] == nil || len(ns) == 0 || ns[0] == nil {
+		return false
+	}
+
+	om, nm := os[0].(map[string]interface{}), ns[0].(map[string]interface{})
+
+	if enabled, ok := om["enabled"].(bool); !ok || !enabled {
+		return false
+	}
+
+	for k, v := range nm {
+		if slices.Contains(domainSAMLOptionsDynamicKeys, k) {
+			continue
+		}
+
+		if !reflect.DeepEqual(v, om[k]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenChangeProgressDetails(apiObject *elasticsearch.ChangeProgressDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ChangeId; v != nil {
+		tfMap["change_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Message; v != nil {
+		tfMap["message"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
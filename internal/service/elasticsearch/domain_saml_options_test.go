@@ -15,6 +15,90 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestSAMLOptionsChangeIsDynamic(t *testing.T) {
+	t.Parallel()
+
+	samlOptions := func(enabled bool, rolesKey, masterUserName string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"enabled": enabled,
+				"idp": []interface{}{
+					map[string]interface{}{
+						"entity_id":        "https://example.com",
+						"metadata_content": "<metadata/>",
+					},
+				},
+				"master_backend_role":     "",
+				"master_user_name":        masterUserName,
+				"roles_key":               rolesKey,
+				"session_timeout_minutes": 60,
+				"subject_key":             "",
+			},
+		}
+	}
+
+	testCases := []struct {
+		TestName string
+		Old      []interface{}
+		New      []interface{}
+		Expected bool
+	}{
+		{
+			TestName: "no old configuration",
+			Old:      []interface{}{},
+			New:      samlOptions(true, "roles", "admin"),
+			Expected: false,
+		},
+		{
+			TestName: "configuration removed",
+			Old:      samlOptions(true, "roles", "admin"),
+			New:      []interface{}{},
+			Expected: false,
+		},
+		{
+			TestName: "SAML disabled",
+			Old:      samlOptions(false, "roles", "admin"),
+			New:      samlOptions(false, "groups", "admin"),
+			Expected: false,
+		},
+		{
+			TestName: "SAML enabled",
+			Old:      samlOptions(false, "roles", "admin"),
+			New:      samlOptions(true, "roles", "admin"),
+			Expected: false,
+		},
+		{
+			TestName: "dynamic option changed",
+			Old:      samlOptions(true, "roles", "admin"),
+			New:      samlOptions(true, "groups", "admin"),
+			Expected: true,
+		},
+		{
+			TestName: "dynamic and other options changed",
+			Old:      samlOptions(true, "roles", "admin"),
+			New:      samlOptions(true, "groups", "root"),
+			Expected: false,
+		},
+		{
+			TestName: "SAML disabled from enabled",
+			Old:      samlOptions(true, "roles", "admin"),
+			New:      samlOptions(false, "roles", "admin"),
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfelasticsearch.SAMLOptionsChangeIsDynamic(testCase.Old, testCase.New); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccElasticsearchDomainSAMLOptions_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domain elasticsearch.ElasticsearchDomainStatus
@@ -0,0 +1,6 @@
+package elasticsearch
+
+// Exports for use in tests only.
+var (
+	SAMLOptionsChangeIsDynamic = samlOptionsChangeIsDynamic
+)
@@ -2,7 +2,10 @@ package emr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -25,26 +28,48 @@ func ResourceBlockPublicAccessConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceBlockPublicAccessConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"block_public_security_group_rules": {
 				Type:     schema.TypeBool,
 				Required: true,
 				ForceNew: true,
 			},
+			"created_by_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"permitted_public_security_group_rule_range": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
+				// Ranges are compared in canonical form so that reordering, or splitting a range into
+				// overlapping pieces, does not force replacement.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					o, n := d.GetChange("permitted_public_security_group_rule_range")
+
+					return portRangesEqual(
+						canonicalPermittedPublicSecurityGroupRuleRanges(expandPermittedPublicSecurityGroupRuleRanges(o.([]interface{}))),
+						canonicalPermittedPublicSecurityGroupRuleRanges(expandPermittedPublicSecurityGroupRuleRanges(n.([]interface{}))),
+					)
+				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"min_range": {
 							Type:             schema.TypeInt,
 							Required:         true,
+							ForceNew:         true,
 							ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
 						},
 						"max_range": {
 							Type:             schema.TypeInt,
 							Required:         true,
+							ForceNew:         true,
 							ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
 						},
 					},
@@ -94,6 +119,13 @@ func resourceBlockPublicAccessConfigurationRead(ctx context.Context, d *schema.R
 	}
 
 	d.Set("block_public_security_group_rules", out.BlockPublicAccessConfiguration.BlockPublicSecurityGroupRules)
+	if v := out.BlockPublicAccessConfigurationMetadata; v != nil {
+		d.Set("created_by_arn", v.CreatedByArn)
+		d.Set("creation_date_time", aws.TimeValue(v.CreationDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_by_arn", nil)
+		d.Set("creation_date_time", nil)
+	}
 	if err := d.Set("permitted_public_security_group_rule_range", flattenPermittedPublicSecurityGroupRuleRanges(out.BlockPublicAccessConfiguration.PermittedPublicSecurityGroupRuleRanges)); err != nil {
 		return create.DiagError(names.EMR, create.ErrActionSetting, ResNameBlockPublicAccessConfiguration, d.Id(), err)
 	}
@@ -119,6 +151,83 @@ func resourceBlockPublicAccessConfigurationDelete(ctx context.Context, d *schema
 	return nil
 }
 
+func resourceBlockPublicAccessConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("permitted_public_security_group_rule_range") {
+		return nil
+	}
+
+	ranges := expandPermittedPublicSecurityGroupRuleRanges(diff.Get("permitted_public_security_group_rule_range").([]interface{}))
+
+	for i, v := range ranges {
+		if minRange, maxRange := aws.Int64Value(v.MinRange), aws.Int64Value(v.MaxRange); minRange > maxRange {
+			return fmt.Errorf("permitted_public_security_group_rule_range.%d: min_range (%d) must be less than or equal to max_range (%d)", i, minRange, maxRange)
+		}
+	}
+
+	if diff.NewValueKnown("block_public_security_group_rules") && !diff.Get("block_public_security_group_rules").(bool) && len(ranges) > 0 {
+		return fmt.Errorf("permitted_public_security_group_rule_range cannot be set when block_public_security_group_rules is false")
+	}
+
+	return nil
+}
+
+// canonicalPermittedPublicSecurityGroupRuleRanges returns the port ranges sorted by starting port, with overlapping ranges merged.
+func canonicalPermittedPublicSecurityGroupRuleRanges(apiObjects []*emr.PortRange) []*emr.PortRange {
+	var ranges []*emr.PortRange
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		minRange := aws.Int64Value(apiObject.MinRange)
+		maxRange := minRange
+		if apiObject.MaxRange != nil {
+			maxRange = aws.Int64Value(apiObject.MaxRange)
+		}
+
+		ranges = append(ranges, &emr.PortRange{MinRange: aws.Int64(minRange), MaxRange: aws.Int64(maxRange)})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		if aws.Int64Value(ranges[i].MinRange) == aws.Int64Value(ranges[j].MinRange) {
+			return aws.Int64Value(ranges[i].MaxRange) < aws.Int64Value(ranges[j].MaxRange)
+		}
+
+		return aws.Int64Value(ranges[i].MinRange) < aws.Int64Value(ranges[j].MinRange)
+	})
+
+	var merged []*emr.PortRange
+
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && aws.Int64Value(r.MinRange) <= aws.Int64Value(merged[n-1].MaxRange) {
+			if aws.Int64Value(r.MaxRange) > aws.Int64Value(merged[n-1].MaxRange) {
+				merged[n-1].MaxRange = r.MaxRange
+			}
+
+			continue
+		}
+
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+func portRangesEqual(a, b []*emr.PortRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if aws.Int64Value(a[i].MinRange) != aws.Int64Value(b[i].MinRange) || aws.Int64Value(a[i].MaxRange) != aws.Int64Value(b[i].MaxRange) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func findDefaultBlockPublicAccessConfiguration() *emr.BlockPublicAccessConfiguration {
 	defaultPort := int64(defaultPermittedPublicSecurityGroupRulePort)
 	defaultPortPointer := &defaultPort
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/emr"
@@ -164,6 +165,57 @@ func TestAccEMRBlockPublicAccessConfiguration_enabledMultiRange(t *testing.T) {
 	})
 }
 
+func TestAccEMRBlockPublicAccessConfiguration_invalidRange(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, emr.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlockPublicAccessConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      blockPublicAccessConfigurationConfig_invalidRangeString,
+				ExpectError: regexp.MustCompile(`min_range \(101\) must be less than or equal to max_range \(100\)`),
+			},
+		},
+	})
+}
+
+func TestAccEMRBlockPublicAccessConfiguration_reorderedRanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_block_public_access_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, emr.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, emr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBlockPublicAccessConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: blockPublicAccessConfigurationConfig_enabledMultiRangeString,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockPublicAccessConfigurationAttributes_enabledMultiRange(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+				),
+			},
+			{
+				Config:   blockPublicAccessConfigurationConfig_reorderedMultiRangeString,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBlockPublicAccessConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
@@ -372,3 +424,30 @@ resource "aws_emr_block_public_access_configuration" "test" {
   }
 }
 `
+
+const blockPublicAccessConfigurationConfig_reorderedMultiRangeString = `
+resource "aws_emr_block_public_access_configuration" "test" {
+  block_public_security_group_rules = true
+
+  permitted_public_security_group_rule_range {
+    min_range = 100
+    max_range = 101
+  }
+
+  permitted_public_security_group_rule_range {
+    min_range = 22
+    max_range = 22
+  }
+}
+`
+
+const blockPublicAccessConfigurationConfig_invalidRangeString = `
+resource "aws_emr_block_public_access_configuration" "test" {
+  block_public_security_group_rules = true
+
+  permitted_public_security_group_rule_range {
+    min_range = 101
+    max_range = 100
+  }
+}
+`
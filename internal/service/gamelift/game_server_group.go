@@ -25,6 +25,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 
 const (
 	gameServerGroupCreatedDefaultTimeout = 10 * time.Minute
+	gameServerGroupUpdatedDefaultTimeout = 10 * time.Minute
 	gameServerGroupDeletedDefaultTimeout = 30 * time.Minute
 )
 
@@ -41,6 +42,7 @@ func ResourceGameServerGroup() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(gameServerGroupCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(gameServerGroupUpdatedDefaultTimeout),
 			Delete: schema.DefaultTimeout(gameServerGroupDeletedDefaultTimeout),
 		},
 
@@ -246,8 +248,8 @@ func resourceGameServerGroupCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.StringValue(out.GameServerGroup.GameServerGroupName))
 
-	if output, err := waitGameServerGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Game Server Group (%s) to become active (%s): %s", d.Id(), *output.StatusReason, err)
+	if _, err := waitGameServerGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Game Server Group (%s) to become active: %s", d.Id(), err)
 	}
 
 	return append(diags, resourceGameServerGroupRead(ctx, d, meta)...)
@@ -370,6 +372,10 @@ func resourceGameServerGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating GameLift Game Server Group (%s): %s", d.Id(), err)
 		}
+
+		if _, err := waitGameServerGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GameLift Game Server Group (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -148,6 +148,13 @@ func TestAccGameLiftGameServerGroup_BalancingStrategy(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"vpc_subnets"},
 			},
+			{
+				Config: testAccGameServerGroupConfig_balancingStrategy(rName, gamelift.BalancingStrategyOnDemandOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "balancing_strategy", gamelift.BalancingStrategyOnDemandOnly),
+				),
+			},
 		},
 	})
 }
@@ -407,6 +414,13 @@ func TestAccGameLiftGameServerGroup_GameServerProtectionPolicy(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"vpc_subnets"},
 			},
+			{
+				Config: testAccGameServerGroupConfig_protectionPolicy(rName, gamelift.GameServerProtectionPolicyNoProtection),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "game_server_protection_policy", gamelift.GameServerProtectionPolicyNoProtection),
+				),
+			},
 		},
 	})
 }
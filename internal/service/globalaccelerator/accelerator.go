@@ -61,9 +61,12 @@ func ResourceAccelerator() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 255),
 						},
 						"flow_logs_s3_prefix": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 255),
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(0, 255),
+								validation.StringDoesNotMatch(regexp.MustCompile(`^/`), "cannot start with a slash"),
+							),
 						},
 					},
 				},
@@ -91,7 +94,11 @@ func ResourceAccelerator() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
 			},
 			"ip_sets": {
 				Type:     schema.TypeList,
@@ -165,7 +172,7 @@ func resourceAcceleratorCreate(ctx context.Context, d *schema.ResourceData, meta
 		input := expandUpdateAcceleratorAttributesInput(v.([]interface{})[0].(map[string]interface{}))
 		input.AcceleratorArn = aws.String(d.Id())
 
-		_, err := conn.UpdateAcceleratorAttributesWithContext(ctx, input)
+		_, err := updateAcceleratorAttributesWithRetry(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
 			return diag.Errorf("updating Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
@@ -249,7 +256,9 @@ func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.IpAddressType = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateAcceleratorWithContext(ctx, input)
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return conn.UpdateAcceleratorWithContext(ctx, input)
+		}, globalaccelerator.ErrCodeConflictException)
 
 		if err != nil {
 			return diag.Errorf("updating Global Accelerator Accelerator (%s): %s", d.Id(), err)
@@ -273,7 +282,7 @@ func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta
 				if aws.BoolValue(oInput.FlowLogsEnabled) && aws.BoolValue(nInput.FlowLogsEnabled) {
 					oInput.FlowLogsEnabled = aws.Bool(false)
 
-					_, err := conn.UpdateAcceleratorAttributesWithContext(ctx, oInput)
+					_, err := updateAcceleratorAttributesWithRetry(ctx, conn, oInput, d.Timeout(schema.TimeoutUpdate))
 
 					if err != nil {
 						return diag.Errorf("updating Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
@@ -284,7 +293,7 @@ func resourceAcceleratorUpdate(ctx context.Context, d *schema.ResourceData, meta
 					}
 				}
 
-				_, err := conn.UpdateAcceleratorAttributesWithContext(ctx, nInput)
+				_, err := updateAcceleratorAttributesWithRetry(ctx, conn, nInput, d.Timeout(schema.TimeoutUpdate))
 
 				if err != nil {
 					return diag.Errorf("updating Global Accelerator Accelerator (%s) attributes: %s", d.Id(), err)
@@ -346,6 +355,24 @@ func resourceAcceleratorDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// updateAcceleratorAttributesWithRetry waits for any in-progress accelerator change to deploy
+// and retries while the attribute update conflicts with another change.
+func updateAcceleratorAttributesWithRetry(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, input *globalaccelerator.UpdateAcceleratorAttributesInput, timeout time.Duration) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error) {
+	if _, err := waitAcceleratorDeployed(ctx, conn, aws.StringValue(input.AcceleratorArn), timeout); err != nil {
+		return nil, err
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.UpdateAcceleratorAttributesWithContext(ctx, input)
+	}, globalaccelerator.ErrCodeConflictException)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*globalaccelerator.UpdateAcceleratorAttributesOutput), nil
+}
+
 func expandUpdateAcceleratorAttributesInput(tfMap map[string]interface{}) *globalaccelerator.UpdateAcceleratorAttributesInput {
 	if tfMap == nil {
 		return nil
@@ -277,6 +277,24 @@ func TestAccGlobalAcceleratorAccelerator_attributes(t *testing.T) {
 	})
 }
 
+func TestAccGlobalAcceleratorAccelerator_attributesInvalidPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAcceleratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAcceleratorConfig_attributes(rName, true, "/flow-logs/"),
+				ExpectError: regexp.MustCompile(`cannot start with a slash`),
+			},
+		},
+	})
+}
+
 func TestAccGlobalAcceleratorAccelerator_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_globalaccelerator_accelerator.test"
@@ -2,6 +2,8 @@ package glue
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/glue"
 )
 
 const (
@@ -14,3 +16,21 @@ const (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+// Job run and crawl states that can be watched by a trigger's predicate conditions.
+func triggerConditionJobRunStates() []string {
+	return []string{
+		glue.JobRunStateFailed,
+		glue.JobRunStateStopped,
+		glue.JobRunStateSucceeded,
+		glue.JobRunStateTimeout,
+	}
+}
+
+func triggerConditionCrawlStates() []string {
+	return []string{
+		glue.CrawlStateCancelled,
+		glue.CrawlStateFailed,
+		glue.CrawlStateSucceeded,
+	}
+}
@@ -0,0 +1,180 @@
+package glue
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_glue_data_quality_result")
+func DataSourceDataQualityResult() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDataQualityResultRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"evaluation_context": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_results": dataQualityRuleResultsSchema(),
+			"ruleset_evaluation_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ruleset_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"score": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataQualityResultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	table := &glue.Table{
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		TableName:    aws.String(d.Get("table_name").(string)),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		table.CatalogId = aws.String(v.(string))
+	}
+
+	input := &glue.ListDataQualityResultsInput{
+		Filter: &glue.DataQualityResultFilterCriteria{
+			DataSource: &glue.DataSource{
+				GlueTable: table,
+			},
+		},
+	}
+
+	results, err := findDataQualityResultDescriptions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Glue Data Quality Results: %s", err)
+	}
+
+	// Newest first, so that the first result matching the requested ruleset is the latest one.
+	sort.Slice(results, func(i, j int) bool {
+		return aws.TimeValue(results[i].StartedOn).After(aws.TimeValue(results[j].StartedOn))
+	})
+
+	rulesetName := d.Get("ruleset_name").(string)
+	var output *glue.GetDataQualityResultOutput
+
+	for _, v := range results {
+		result, err := FindDataQualityResultByID(ctx, conn, aws.StringValue(v.ResultId))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Result (%s): %s", aws.StringValue(v.ResultId), err)
+		}
+
+		if rulesetName == "" || aws.StringValue(result.RulesetName) == rulesetName {
+			output = result
+			break
+		}
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Result: %s", tfresource.NewEmptyResultError(input))
+	}
+
+	d.SetId(aws.StringValue(output.ResultId))
+	if output.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(output.CompletedOn).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	d.Set("evaluation_context", output.EvaluationContext)
+	d.Set("job_name", output.JobName)
+	d.Set("job_run_id", output.JobRunId)
+	d.Set("result_id", output.ResultId)
+	if err := d.Set("rule_results", flattenDataQualityRuleResults(output.RuleResults, false)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule_results: %s", err)
+	}
+	d.Set("ruleset_evaluation_run_id", output.RulesetEvaluationRunId)
+	d.Set("ruleset_name", output.RulesetName)
+	d.Set("score", output.Score)
+	if output.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(output.StartedOn).Format(time.RFC3339))
+	} else {
+		d.Set("started_on", nil)
+	}
+
+	return diags
+}
+
+func findDataQualityResultDescriptions(ctx context.Context, conn *glue.Glue, input *glue.ListDataQualityResultsInput) ([]*glue.DataQualityResultDescription, error) {
+	var output []*glue.DataQualityResultDescription
+
+	err := conn.ListDataQualityResultsPagesWithContext(ctx, input, func(page *glue.ListDataQualityResultsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Results {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
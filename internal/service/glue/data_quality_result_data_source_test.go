@@ -0,0 +1,52 @@
+package glue_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlueDataQualityResultDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glue_data_quality_result.test"
+	runResourceName := "aws_glue_data_quality_ruleset_evaluation_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityResultDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "result_id", runResourceName, "results.0.result_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule_results.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ruleset_evaluation_run_id", runResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ruleset_name", runResourceName, "results.0.ruleset_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "score", runResourceName, "results.0.score"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "started_on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataQualityResultDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetEvaluationRunConfig_basic(rName, "1"), `
+data "aws_glue_data_quality_result" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  table_name    = aws_glue_catalog_table.test.name
+  ruleset_name  = aws_glue_data_quality_ruleset.test.name
+
+  depends_on = [aws_glue_data_quality_ruleset_evaluation_run.test]
+}
+`)
+}
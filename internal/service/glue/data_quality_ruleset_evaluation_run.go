@@ -0,0 +1,511 @@
+package glue
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_glue_data_quality_ruleset_evaluation_run")
+func ResourceDataQualityRulesetEvaluationRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataQualityRulesetEvaluationRunCreate,
+		ReadWithoutTimeout:   resourceDataQualityRulesetEvaluationRunRead,
+		UpdateWithoutTimeout: resourceDataQualityRulesetEvaluationRunRead,
+		DeleteWithoutTimeout: resourceDataQualityRulesetEvaluationRunDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_run_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"results_s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"completed_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_table": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"additional_options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"catalog_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"connection_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"table_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"error_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"number_of_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"result_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failed_rules": dataQualityRuleResultsSchema(),
+						"result_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ruleset_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ruleset_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"started_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func dataQualityRuleResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"evaluation_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"result": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceDataQualityRulesetEvaluationRunCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	input := &glue.StartDataQualityRulesetEvaluationRunInput{
+		Role:         aws.String(d.Get("role").(string)),
+		RulesetNames: flex.ExpandStringSet(d.Get("ruleset_names").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("additional_run_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AdditionalRunOptions = expandDataQualityEvaluationRunAdditionalRunOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("data_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSource = expandDataQualityDataSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		input.Timeout = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Starting Glue Data Quality Ruleset Evaluation Run: %s", input)
+	output, err := conn.StartDataQualityRulesetEvaluationRunWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Glue Data Quality Ruleset Evaluation Run: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.RunId))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitDataQualityRulesetEvaluationRunCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Glue Data Quality Ruleset Evaluation Run (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataQualityRulesetEvaluationRunRead(ctx, d, meta)...)
+}
+
+func resourceDataQualityRulesetEvaluationRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	output, err := FindDataQualityRulesetEvaluationRunByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Data Quality Ruleset Evaluation Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	if output.AdditionalRunOptions != nil {
+		if err := d.Set("additional_run_options", []interface{}{flattenDataQualityEvaluationRunAdditionalRunOptions(output.AdditionalRunOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting additional_run_options: %s", err)
+		}
+	} else {
+		d.Set("additional_run_options", nil)
+	}
+	if output.CompletedOn != nil {
+		d.Set("completed_on", aws.TimeValue(output.CompletedOn).Format(time.RFC3339))
+	} else {
+		d.Set("completed_on", nil)
+	}
+	if output.DataSource != nil {
+		if err := d.Set("data_source", []interface{}{flattenDataQualityDataSource(output.DataSource)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting data_source: %s", err)
+		}
+	} else {
+		d.Set("data_source", nil)
+	}
+	d.Set("error_string", output.ErrorString)
+	d.Set("execution_time", output.ExecutionTime)
+	d.Set("number_of_workers", output.NumberOfWorkers)
+	d.Set("result_ids", aws.StringValueSlice(output.ResultIds))
+	d.Set("role", output.Role)
+	d.Set("ruleset_names", aws.StringValueSlice(output.RulesetNames))
+	if output.StartedOn != nil {
+		d.Set("started_on", aws.TimeValue(output.StartedOn).Format(time.RFC3339))
+	} else {
+		d.Set("started_on", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("timeout", output.Timeout)
+
+	var results []interface{}
+
+	for _, resultID := range aws.StringValueSlice(output.ResultIds) {
+		result, err := FindDataQualityResultByID(ctx, conn, resultID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Glue Data Quality Result (%s): %s", resultID, err)
+		}
+
+		results = append(results, map[string]interface{}{
+			"failed_rules": flattenDataQualityRuleResults(result.RuleResults, true),
+			"result_id":    aws.StringValue(result.ResultId),
+			"ruleset_name": aws.StringValue(result.RulesetName),
+			"score":        aws.Float64Value(result.Score),
+		})
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataQualityRulesetEvaluationRunDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	// Evaluation runs can't be deleted. A run that is still in progress is cancelled.
+	switch d.Get("status").(string) {
+	case glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling Glue Data Quality Ruleset Evaluation Run: %s", d.Id())
+	_, err := conn.CancelDataQualityRulesetEvaluationRunWithContext(ctx, &glue.CancelDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException, glue.ErrCodeIllegalSessionStateException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling Glue Data Quality Ruleset Evaluation Run (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandDataQualityEvaluationRunAdditionalRunOptions(tfMap map[string]interface{}) *glue.DataQualityEvaluationRunAdditionalRunOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.DataQualityEvaluationRunAdditionalRunOptions{}
+
+	if v, ok := tfMap["cloudwatch_metrics_enabled"].(bool); ok {
+		apiObject.CloudWatchMetricsEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["results_s3_prefix"].(string); ok && v != "" {
+		apiObject.ResultsS3Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataQualityDataSource(tfMap map[string]interface{}) *glue.DataSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.DataSource{}
+
+	if v, ok := tfMap["glue_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GlueTable = expandDataQualityGlueTable(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandDataQualityGlueTable(tfMap map[string]interface{}) *glue.Table {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &glue.Table{}
+
+	if v, ok := tfMap["additional_options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AdditionalOptions = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_name"].(string); ok && v != "" {
+		apiObject.ConnectionName = aws.String(v)
+	}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataQualityEvaluationRunAdditionalRunOptions(apiObject *glue.DataQualityEvaluationRunAdditionalRunOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchMetricsEnabled; v != nil {
+		tfMap["cloudwatch_metrics_enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.ResultsS3Prefix; v != nil {
+		tfMap["results_s3_prefix"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenDataQualityDataSource(apiObject *glue.DataSource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GlueTable; v != nil {
+		tfMap["glue_table"] = []interface{}{flattenDataQualityGlueTable(v)}
+	}
+
+	return tfMap
+}
+
+func flattenDataQualityGlueTable(apiObject *glue.Table) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdditionalOptions; v != nil {
+		tfMap["additional_options"] = aws.StringValueMap(v)
+	}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ConnectionName; v != nil {
+		tfMap["connection_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+// flattenDataQualityRuleResults flattens rule results, optionally keeping only the rules that didn't pass.
+func flattenDataQualityRuleResults(apiObjects []*glue.DataQualityRuleResult, failedOnly bool) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if failedOnly && aws.StringValue(apiObject.Result) == glue.DataQualityRuleResultStatusPass {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description":        aws.StringValue(apiObject.Description),
+			"evaluation_message": aws.StringValue(apiObject.EvaluationMessage),
+			"name":               aws.StringValue(apiObject.Name),
+			"result":             aws.StringValue(apiObject.Result),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,203 @@
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglue "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
+)
+
+func TestAccGlueDataQualityRulesetEvaluationRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_data_quality_ruleset_evaluation_run.test"
+	rulesetResourceName := "aws_glue_data_quality_ruleset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataQualityRulesetEvaluationRunConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetEvaluationRunExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "completed_on"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_source.0.glue_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "result_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.failed_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.failed_rules.0.result", "FAIL"),
+					resource.TestCheckResourceAttrPair(resourceName, "results.0.ruleset_name", rulesetResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.score", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, "ruleset_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "wait_for_completion"},
+			},
+			{
+				Config: testAccDataQualityRulesetEvaluationRunConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataQualityRulesetEvaluationRunExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUCCEEDED"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataQualityRulesetEvaluationRunExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Data Quality Ruleset Evaluation Run ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
+
+		_, err := tfglue.FindDataQualityRulesetEvaluationRunByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/data.csv"
+  content = "1,a\n2,\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  table_type    = "EXTERNAL_TABLE"
+
+  storage_descriptor {
+    location      = "s3://${aws_s3_bucket.test.bucket}/data/"
+    input_format  = "org.apache.hadoop.mapred.TextInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat"
+
+    ser_de_info {
+      serialization_library = "org.apache.hadoop.hive.serde2.lazy.LazySimpleSerDe"
+
+      parameters = {
+        "field.delim" = ","
+      }
+    }
+
+    columns {
+      name = "id"
+      type = "int"
+    }
+
+    columns {
+      name = "value"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_glue_data_quality_ruleset" "test" {
+  name    = %[1]q
+  ruleset = "Rules = [IsComplete \"id\", IsComplete \"value\"]"
+
+  target_table {
+    database_name = aws_glue_catalog_database.test.name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+}
+`, rName)
+}
+
+func testAccDataQualityRulesetEvaluationRunConfig_basic(rName, run string) string {
+	return acctest.ConfigCompose(testAccDataQualityRulesetEvaluationRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_data_quality_ruleset_evaluation_run" "test" {
+  role                = aws_iam_role.test.arn
+  ruleset_names       = [aws_glue_data_quality_ruleset.test.name]
+  wait_for_completion = true
+
+  data_source {
+    glue_table {
+      database_name = aws_glue_catalog_database.test.name
+      table_name    = aws_glue_catalog_table.test.name
+    }
+  }
+
+  triggers = {
+    run = %[1]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy.test, aws_s3_object.test]
+}
+`, run))
+}
@@ -287,3 +287,78 @@ func FindCrawlerByName(ctx context.Context, conn *glue.Glue, name string) (*glue
 
 	return output.Crawler, nil
 }
+
+func FindDataQualityRulesetByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetDataQualityRulesetOutput, error) {
+	input := &glue.GetDataQualityRulesetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDataQualityRulesetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataQualityRulesetEvaluationRunByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	input := &glue.GetDataQualityRulesetEvaluationRunInput{
+		RunId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityRulesetEvaluationRunWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDataQualityResultByID(ctx context.Context, conn *glue.Glue, id string) (*glue.GetDataQualityResultOutput, error) {
+	input := &glue.GetDataQualityResultInput{
+		ResultId: aws.String(id),
+	}
+
+	output, err := conn.GetDataQualityResultWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
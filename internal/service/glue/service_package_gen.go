@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDataCatalogEncryptionSettings,
 			TypeName: "aws_glue_data_catalog_encryption_settings",
 		},
+		{
+			Factory:  DataSourceDataQualityResult,
+			TypeName: "aws_glue_data_quality_result",
+		},
 		{
 			Factory:  DataSourceScript,
 			TypeName: "aws_glue_script",
@@ -66,6 +70,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceDataCatalogEncryptionSettings,
 			TypeName: "aws_glue_data_catalog_encryption_settings",
 		},
+		{
+			Factory:  ResourceDataQualityRuleset,
+			TypeName: "aws_glue_data_quality_ruleset",
+		},
+		{
+			Factory:  ResourceDataQualityRulesetEvaluationRun,
+			TypeName: "aws_glue_data_quality_ruleset_evaluation_run",
+		},
 		{
 			Factory:  ResourceDevEndpoint,
 			TypeName: "aws_glue_dev_endpoint",
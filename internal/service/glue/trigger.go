@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +21,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_glue_trigger")
@@ -34,10 +36,15 @@ func ResourceTrigger() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffTriggerEventBatchingCondition,
+			customizeDiffTriggerPredicate,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"actions": {
@@ -103,6 +110,7 @@ func ResourceTrigger() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				MinItems: 1,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"batch_size": {
@@ -296,6 +304,13 @@ func resourceTriggerCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Activation of scheduled and conditional triggers started on creation is asynchronous.
+	if aws.BoolValue(input.StartOnCreation) && triggerActivates(triggerType) {
+		if _, err := waitTriggerActivated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Glue Trigger (%s) to be Activated: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTriggerRead(ctx, d, meta)...)
 }
 
@@ -434,6 +449,12 @@ func resourceTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "starting Glue Trigger (%s): %s", d.Id(), err)
 			}
+
+			if triggerActivates(d.Get("type").(string)) {
+				if _, err := waitTriggerActivated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Glue Trigger (%s) to be Activated: %s", d.Id(), err)
+				}
+			}
 		} else {
 			//Skip if Trigger is type is ON_DEMAND and is in CREATED state as this means the trigger is not running or has ran already.
 			if !(d.Get("type").(string) == glue.TriggerTypeOnDemand && d.Get("state").(string) == glue.TriggerStateCreated) {
@@ -481,6 +502,77 @@ func resourceTriggerDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// triggerActivates returns whether a trigger of the specified type remains ACTIVATED once started.
+func triggerActivates(triggerType string) bool {
+	return triggerType == glue.TriggerTypeScheduled || triggerType == glue.TriggerTypeConditional
+}
+
+func customizeDiffTriggerEventBatchingCondition(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("event_batching_condition"); ok && len(v.([]interface{})) > 0 {
+		if triggerType := diff.Get("type").(string); triggerType != glue.TriggerTypeEvent {
+			return fmt.Errorf("event_batching_condition can only be set for %s triggers, not %s", glue.TriggerTypeEvent, triggerType)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffTriggerPredicate validates the trigger's predicate conditions.
+// Each condition watches either a job's run state or a crawler's crawl state, and only terminal states can be watched.
+func customizeDiffTriggerPredicate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("predicate")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if triggerType := diff.Get("type").(string); triggerType != glue.TriggerTypeConditional {
+		return fmt.Errorf("predicate can only be set for %s triggers, not %s", glue.TriggerTypeConditional, triggerType)
+	}
+
+	conditions := v.([]interface{})[0].(map[string]interface{})["conditions"].([]interface{})
+
+	for i, v := range conditions {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		prefix := fmt.Sprintf("predicate.0.conditions.%d", i)
+
+		if !diff.NewValueKnown(prefix+".job_name") || !diff.NewValueKnown(prefix+".crawler_name") || !diff.NewValueKnown(prefix+".state") || !diff.NewValueKnown(prefix+".crawl_state") {
+			continue
+		}
+
+		jobName, crawlerName := tfMap["job_name"].(string), tfMap["crawler_name"].(string)
+		state, crawlState := tfMap["state"].(string), tfMap["crawl_state"].(string)
+
+		switch {
+		case jobName != "" && crawlerName != "":
+			return fmt.Errorf("%s: only one of job_name or crawler_name can be set", prefix)
+		case jobName == "" && crawlerName == "":
+			return fmt.Errorf("%s: one of job_name or crawler_name must be set", prefix)
+		case jobName != "":
+			if crawlState != "" {
+				return fmt.Errorf("%s: crawl_state can't be set for a job condition, use state", prefix)
+			}
+			if !slices.Contains(triggerConditionJobRunStates(), state) {
+				return fmt.Errorf("%s: state must be one of %v for a job condition, got %q", prefix, triggerConditionJobRunStates(), state)
+			}
+		case crawlerName != "":
+			if state != "" {
+				return fmt.Errorf("%s: state can't be set for a crawler condition, use crawl_state", prefix)
+			}
+			if !slices.Contains(triggerConditionCrawlStates(), crawlState) {
+				return fmt.Errorf("%s: crawl_state must be one of %v for a crawler condition, got %q", prefix, triggerConditionCrawlStates(), crawlState)
+			}
+		}
+	}
+
+	return nil
+}
+
 func deleteTrigger(ctx context.Context, conn *glue.Glue, Name string) error {
 	input := &glue.DeleteTriggerInput{
 		Name: aws.String(Name),
@@ -3,6 +3,7 @@ package glue_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -288,6 +289,7 @@ func TestAccGlueTrigger_startOnCreate(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTriggerExists(ctx, resourceName, &trigger),
 					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(1 2 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "state", glue.TriggerStateActivated),
 				),
 			},
 			{
@@ -463,6 +465,32 @@ func TestAccGlueTrigger_Actions_security(t *testing.T) {
 	})
 }
 
+func TestAccGlueTrigger_invalidConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTriggerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTriggerConfig_predicate(rName, "RUNNING"),
+				ExpectError: regexp.MustCompile(`state must be one of`),
+			},
+			{
+				Config:      testAccTriggerConfig_predicateJobCrawlState(rName),
+				ExpectError: regexp.MustCompile(`crawl_state can't be set for a job condition`),
+			},
+			{
+				Config:      testAccTriggerConfig_scheduleEventBatchingCondition(rName),
+				ExpectError: regexp.MustCompile(`event_batching_condition can only be set for EVENT triggers`),
+			},
+		},
+	})
+}
+
 func TestAccGlueTrigger_onDemandDisable(t *testing.T) {
 	ctx := acctest.Context(t)
 	var trigger glue.Trigger
@@ -934,3 +962,41 @@ resource "aws_glue_trigger" "test" {
 }
 `, rName))
 }
+
+func testAccTriggerConfig_predicateJobCrawlState(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_required(rName), fmt.Sprintf(`
+resource "aws_glue_trigger" "test" {
+  name = %[1]q
+  type = "CONDITIONAL"
+
+  actions {
+    job_name = aws_glue_job.test.name
+  }
+
+  predicate {
+    conditions {
+      job_name    = aws_glue_job.test.name
+      crawl_state = "SUCCEEDED"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTriggerConfig_scheduleEventBatchingCondition(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_required(rName), fmt.Sprintf(`
+resource "aws_glue_trigger" "test" {
+  name     = %[1]q
+  schedule = "cron(1 2 * * ? *)"
+  type     = "SCHEDULED"
+
+  actions {
+    job_name = aws_glue_job.test.name
+  }
+
+  event_batching_condition {
+    batch_size = 1
+  }
+}
+`, rName))
+}
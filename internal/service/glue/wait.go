@@ -137,6 +137,31 @@ func waitTriggerCreated(ctx context.Context, conn *glue.Glue, triggerName string
 	return nil, err
 }
 
+// waitTriggerActivated waits for a Trigger to return Activated
+func waitTriggerActivated(ctx context.Context, conn *glue.Glue, triggerName string, timeout time.Duration) (*glue.GetTriggerOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			glue.TriggerStateActivating,
+			glue.TriggerStateCreated,
+			glue.TriggerStateCreating,
+			glue.TriggerStateDeactivated,
+			glue.TriggerStateDeactivating,
+			glue.TriggerStateUpdating,
+		},
+		Target:  []string{glue.TriggerStateActivated},
+		Refresh: statusTrigger(ctx, conn, triggerName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitTriggerDeleted waits for a Trigger to return Deleted
 func waitTriggerDeleted(ctx context.Context, conn *glue.Glue, triggerName string) (*glue.GetTriggerOutput, error) {
 	stateConf := &resource.StateChangeConf{
@@ -230,3 +255,25 @@ func waitPartitionIndexDeleted(ctx context.Context, conn *glue.Glue, id string,
 
 	return nil, err
 }
+
+func waitDataQualityRulesetEvaluationRunCompleted(ctx context.Context, conn *glue.Glue, id string, timeout time.Duration) (*glue.GetDataQualityRulesetEvaluationRunOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.TaskStatusTypeStarting, glue.TaskStatusTypeRunning, glue.TaskStatusTypeStopping},
+		Target:  []string{glue.TaskStatusTypeSucceeded},
+		Refresh: statusDataQualityRulesetEvaluationRun(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glue.GetDataQualityRulesetEvaluationRunOutput); ok {
+		if errorString := aws.StringValue(output.ErrorString); errorString != "" {
+			tfresource.SetLastError(err, errors.New(errorString))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
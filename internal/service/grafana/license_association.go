@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -37,6 +38,13 @@ func ResourceLicenseAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"grafana_token": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
 			"license_expiration": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -65,8 +73,20 @@ func resourceLicenseAssociationCreate(ctx context.Context, d *schema.ResourceDat
 		WorkspaceId: aws.String(d.Get("workspace_id").(string)),
 	}
 
+	var opts []request.Option
+	if v, ok := d.GetOk("grafana_token"); ok {
+		// The Grafana Labs token is sent as a request header.
+		opts = append(opts, func(r *request.Request) {
+			r.HTTPRequest.Header.Set("Grafana-Token", v.(string))
+		})
+	}
+
 	log.Printf("[DEBUG] Creating Grafana License Association: %s", input)
-	output, err := conn.AssociateLicenseWithContext(ctx, input)
+	output, err := conn.AssociateLicenseWithContext(ctx, input, opts...)
+
+	if aws.StringValue(input.LicenseType) == managedgrafana.LicenseTypeEnterpriseFreeTrial && tfawserr.ErrMessageContains(err, managedgrafana.ErrCodeValidationException, "trial") {
+		return sdkdiag.AppendErrorf(diags, "creating Grafana License Association: the Enterprise free trial is no longer available for Grafana Workspace (%s), use license_type %q with grafana_token instead: %s", aws.StringValue(input.WorkspaceId), managedgrafana.LicenseTypeEnterprise, err)
+	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Grafana License Association: %s", err)
@@ -127,10 +147,21 @@ func resourceLicenseAssociationDelete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if err != nil {
+		// The workspace may already be deleted or in the process of being deleted.
+		if workspace, findErr := FindWorkspaceByID(ctx, conn, d.Id()); tfresource.NotFound(findErr) || (findErr == nil && aws.StringValue(workspace.Status) == managedgrafana.WorkspaceStatusDeleting) {
+			return diags
+		}
+
 		return sdkdiag.AppendErrorf(diags, "deleting Grafana License Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitWorkspaceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	_, err = waitWorkspaceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Grafana License Association (%s) delete: %s", d.Id(), err)
 	}
 
@@ -3,6 +3,7 @@ package grafana_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -40,6 +41,36 @@ func testAccLicenseAssociation_freeTrial(t *testing.T) {
 	})
 }
 
+func testAccLicenseAssociation_enterpriseToken(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "GRAFANA_TOKEN"
+	grafanaToken := os.Getenv(key)
+	if grafanaToken == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_license_association.test"
+	workspaceResourceName := "aws_grafana_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, managedgrafana.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		CheckDestroy:             testAccCheckLicenseAssociationDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseAssociationConfig_grafanaToken(rName, grafanaToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "free_trial_expiration", ""),
+					resource.TestCheckResourceAttr(resourceName, "license_type", managedgrafana.LicenseTypeEnterprise),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", workspaceResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccLicenseAssociationConfig_basic(rName string, licenseType string) string {
 	return acctest.ConfigCompose(testAccWorkspaceConfig_authenticationProvider(rName, "SAML"), fmt.Sprintf(`
 resource "aws_grafana_license_association" "test" {
@@ -49,6 +80,16 @@ resource "aws_grafana_license_association" "test" {
 `, licenseType))
 }
 
+func testAccLicenseAssociationConfig_grafanaToken(rName, grafanaToken string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_authenticationProvider(rName, "SAML"), fmt.Sprintf(`
+resource "aws_grafana_license_association" "test" {
+  workspace_id  = aws_grafana_workspace.test.id
+  license_type  = "ENTERPRISE"
+  grafana_token = %[1]q
+}
+`, grafanaToken))
+}
+
 func testAccCheckLicenseAssociationExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -41,6 +41,7 @@ func TestAccGrafana_serial(t *testing.T) {
 		},
 		"LicenseAssociation": {
 			"enterpriseFreeTrial": testAccLicenseAssociation_freeTrial,
+			"enterpriseToken":     testAccLicenseAssociation_enterpriseToken,
 		},
 		"SamlConfiguration": {
 			"basic":         testAccWorkspaceSAMLConfiguration_basic,
@@ -54,8 +54,9 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"invitationMessage":  testAccMember_invitationMessage,
 		},
 		"PublishingDestination": {
-			"basic":      testAccPublishingDestination_basic,
-			"disappears": testAccPublishingDestination_disappears,
+			"basic":                 testAccPublishingDestination_basic,
+			"disappears":            testAccPublishingDestination_disappears,
+			"keyPolicyMissingGrant": testAccPublishingDestination_keyPolicyMissingGrant,
 		},
 	}
 
@@ -2,21 +2,33 @@ package guardduty
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"path"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	publishingDestinationServicePrincipal = "guardduty.amazonaws.com"
+)
+
 // @SDKResource("aws_guardduty_publishing_destination")
 func ResourcePublishingDestination() *schema.Resource {
 	return &schema.Resource{
@@ -51,8 +63,30 @@ func ResourcePublishingDestination() *schema.Resource {
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validate_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
+
+		CustomizeDiff: resourcePublishingDestinationCustomizeDiff,
+	}
+}
+
+func resourcePublishingDestinationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A destination that is no longer publishing is re-submitted on the next apply.
+	if diff.Id() != "" {
+		if v := diff.Get("status").(string); v != "" && v != guardduty.PublishingStatusPublishing {
+			return diff.SetNewComputed("status")
+		}
 	}
+
+	return nil
 }
 
 func resourcePublishingDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -60,6 +94,13 @@ func resourcePublishingDestinationCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).GuardDutyConn()
 
 	detectorID := d.Get("detector_id").(string)
+
+	if d.Get("validate_permissions").(bool) {
+		if err := waitPublishingDestinationPermissions(ctx, meta, d.Get("destination_arn").(string), d.Get("kms_key_arn").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating GuardDuty Publishing Destination: %s", err)
+		}
+	}
+
 	input := guardduty.CreatePublishingDestinationInput{
 		DetectorId: aws.String(detectorID),
 		DestinationProperties: &guardduty.DestinationProperties{
@@ -69,11 +110,17 @@ func resourcePublishingDestinationCreate(ctx context.Context, d *schema.Resource
 		DestinationType: aws.String(d.Get("destination_type").(string)),
 	}
 
-	output, err := conn.CreatePublishingDestinationWithContext(ctx, &input)
+	// Retry while policies created in the same apply propagate.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, publishingDestinationPropagationTimeout, func() (interface{}, error) {
+		return conn.CreatePublishingDestinationWithContext(ctx, &input)
+	}, guardduty.ErrCodeBadRequestException, "does not have permission")
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating GuardDuty Publishing Destination: %s", err)
 	}
 
+	output := outputRaw.(*guardduty.CreatePublishingDestinationOutput)
+
 	d.SetId(fmt.Sprintf("%s:%s", d.Get("detector_id"), aws.StringValue(output.DestinationId)))
 
 	_, err = waitPublishingDestinationCreated(ctx, conn, aws.StringValue(output.DestinationId), detectorID)
@@ -115,6 +162,7 @@ func resourcePublishingDestinationRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("destination_type", gdo.DestinationType)
 	d.Set("kms_key_arn", gdo.DestinationProperties.KmsKeyArn)
 	d.Set("destination_arn", gdo.DestinationProperties.DestinationArn)
+	d.Set("status", gdo.Status)
 	return diags
 }
 
@@ -128,17 +176,37 @@ func resourcePublishingDestinationUpdate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
 	}
 
-	input := guardduty.UpdatePublishingDestinationInput{
-		DestinationId: aws.String(destinationId),
-		DetectorId:    aws.String(detectorId),
-		DestinationProperties: &guardduty.DestinationProperties{
-			DestinationArn: aws.String(d.Get("destination_arn").(string)),
-			KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
-		},
-	}
+	// Only re-submit the destination when it changed or is no longer publishing, not for validate_permissions changes.
+	o, _ := d.GetChange("status")
+	notPublishing := o.(string) != "" && o.(string) != guardduty.PublishingStatusPublishing
+
+	if d.HasChanges("destination_arn", "kms_key_arn") || notPublishing {
+		input := guardduty.UpdatePublishingDestinationInput{
+			DestinationId: aws.String(destinationId),
+			DetectorId:    aws.String(detectorId),
+			DestinationProperties: &guardduty.DestinationProperties{
+				DestinationArn: aws.String(d.Get("destination_arn").(string)),
+				KmsKeyArn:      aws.String(d.Get("kms_key_arn").(string)),
+			},
+		}
 
-	if _, err = conn.UpdatePublishingDestinationWithContext(ctx, &input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+		if d.Get("validate_permissions").(bool) {
+			if err := waitPublishingDestinationPermissions(ctx, meta, d.Get("destination_arn").(string), d.Get("kms_key_arn").(string)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+			}
+		}
+
+		_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, publishingDestinationPropagationTimeout, func() (interface{}, error) {
+			return conn.UpdatePublishingDestinationWithContext(ctx, &input)
+		}, guardduty.ErrCodeBadRequestException, "does not have permission")
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPublishingDestinationCreated(ctx, conn, destinationId, detectorId); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Publishing Destination (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
@@ -183,3 +251,125 @@ func DecodePublishDestinationID(id string) (destinationID, detectorID string, er
 	detectorID = parts[0]
 	return
 }
+
+// waitPublishingDestinationPermissions checks that the destination's bucket and key policies grant
+// GuardDuty access, retrying while policies created in the same apply propagate.
+func waitPublishingDestinationPermissions(ctx context.Context, meta interface{}, destinationARN, kmsKeyARN string) error {
+	return tfresource.Retry(ctx, publishingDestinationPropagationTimeout, func() *resource.RetryError {
+		if err := checkPublishingDestinationPermissions(ctx, meta, destinationARN, kmsKeyARN); err != nil {
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+}
+
+func checkPublishingDestinationPermissions(ctx context.Context, meta interface{}, destinationARN, kmsKeyARN string) error {
+	var errs *multierror.Error
+
+	if v, err := arn.Parse(destinationARN); err == nil {
+		bucket := strings.SplitN(v.Resource, "/", 2)[0]
+
+		output, err := meta.(*conns.AWSClient).S3Conn().GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy"):
+			errs = multierror.Append(errs, fmt.Errorf("S3 bucket (%s) has no bucket policy; it must allow %s to perform %s", bucket, publishingDestinationServicePrincipal, "s3:PutObject"))
+		case err != nil:
+			// The bucket may be in another account; leave validation to GuardDuty.
+			log.Printf("[WARN] Unable to read S3 bucket (%s) policy, skipping GuardDuty permissions check: %s", bucket, err)
+		default:
+			if ok, err := policyAllowsServicePrincipal(aws.StringValue(output.Policy), publishingDestinationServicePrincipal, "s3:PutObject"); err != nil {
+				log.Printf("[WARN] Unable to parse S3 bucket (%s) policy, skipping GuardDuty permissions check: %s", bucket, err)
+			} else if !ok {
+				errs = multierror.Append(errs, fmt.Errorf("S3 bucket (%s) policy does not allow %s to perform %s", bucket, publishingDestinationServicePrincipal, "s3:PutObject"))
+			}
+		}
+	}
+
+	output, err := meta.(*conns.AWSClient).KMSConn().GetKeyPolicyWithContext(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(kmsKeyARN),
+		PolicyName: aws.String("default"),
+	})
+
+	if err != nil {
+		// The key may be in another account; leave validation to GuardDuty.
+		log.Printf("[WARN] Unable to read KMS key (%s) policy, skipping GuardDuty permissions check: %s", kmsKeyARN, err)
+	} else if ok, err := policyAllowsServicePrincipal(aws.StringValue(output.Policy), publishingDestinationServicePrincipal, "kms:GenerateDataKey"); err != nil {
+		log.Printf("[WARN] Unable to parse KMS key (%s) policy, skipping GuardDuty permissions check: %s", kmsKeyARN, err)
+	} else if !ok {
+		errs = multierror.Append(errs, fmt.Errorf("KMS key (%s) policy does not allow %s to perform %s", kmsKeyARN, publishingDestinationServicePrincipal, "kms:GenerateDataKey"))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// policyAllowsServicePrincipal reports whether any Allow statement in the policy document
+// could grant the service principal the action. Conditions are not evaluated, and statements
+// using NotPrincipal or NotAction are assumed to grant access.
+func policyAllowsServicePrincipal(policy, servicePrincipal, action string) (bool, error) {
+	var document struct {
+		Statement interface{}
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+
+	var statements []interface{}
+	switch v := document.Statement.(type) {
+	case []interface{}:
+		statements = v
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	}
+
+	for _, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok || statement["Effect"] != "Allow" {
+			continue
+		}
+
+		principalMatches := statement["NotPrincipal"] != nil
+		switch v := statement["Principal"].(type) {
+		case string:
+			principalMatches = principalMatches || v == "*"
+		case map[string]interface{}:
+			principalMatches = principalMatches || policyValueMatches(v["AWS"], "*") || policyValueMatches(v["Service"], servicePrincipal)
+		}
+
+		if principalMatches && (statement["NotAction"] != nil || policyValueMatches(statement["Action"], action)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// policyValueMatches reports whether a policy element (a string or list of strings,
+// possibly containing wildcards) matches the value.
+func policyValueMatches(element interface{}, value string) bool {
+	var patterns []string
+
+	switch v := element.(type) {
+	case string:
+		patterns = []string{v}
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				patterns = append(patterns, v)
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value)); ok {
+			return true
+		}
+	}
+
+	return false
+}
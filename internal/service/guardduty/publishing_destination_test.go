@@ -3,6 +3,7 @@ package guardduty_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,12 +37,33 @@ func testAccPublishingDestination_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "detector_id", detectorResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", bucketResourceName, "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", kmsKeyResourceName, "arn"),
-					resource.TestCheckResourceAttr(resourceName, "destination_type", "S3")),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "S3"),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.PublishingStatusPublishing),
+					resource.TestCheckResourceAttr(resourceName, "validate_permissions", "true")),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_permissions"},
+			},
+		},
+	})
+}
+
+func testAccPublishingDestination_keyPolicyMissingGrant(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, guardduty.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPublishingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPublishingDestinationConfig_keyPolicyMissingGrant(bucketName),
+				ExpectError: regexp.MustCompile(`KMS key \(.+\) policy does not allow guardduty.amazonaws.com to perform kms:GenerateDataKey`),
 			},
 		},
 	})
@@ -185,6 +207,63 @@ resource "aws_guardduty_publishing_destination" "test" {
 }`, bucketName)
 }
 
+func testAccPublishingDestinationConfig_keyPolicyMissingGrant(bucketName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "bucket_pol" {
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["${aws_s3_bucket.gd_bucket.arn}/*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["guardduty.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+
+  statement {
+    actions   = ["s3:GetBucketLocation"]
+    resources = [aws_s3_bucket.gd_bucket.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["guardduty.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_guardduty_detector" "test_gd" {
+  enable = true
+}
+
+resource "aws_s3_bucket" "gd_bucket" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "gd_bucket_policy" {
+  bucket = aws_s3_bucket.gd_bucket.id
+  policy = data.aws_iam_policy_document.bucket_pol.json
+}
+
+# The default key policy does not grant GuardDuty access.
+resource "aws_kms_key" "gd_key" {
+  description             = "Temporary key for AccTest of TF"
+  deletion_window_in_days = 7
+}
+
+resource "aws_guardduty_publishing_destination" "test" {
+  detector_id     = aws_guardduty_detector.test_gd.id
+  destination_arn = aws_s3_bucket.gd_bucket.arn
+  kms_key_arn     = aws_kms_key.gd_key.arn
+
+  depends_on = [
+    aws_s3_bucket_policy.gd_bucket_policy,
+  ]
+}`, bucketName)
+}
+
 func testAccCheckPublishingDestinationExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -18,6 +18,9 @@ const (
 	// Maximum amount of time to wait for a PublishingDestination to return Publishing
 	publishingDestinationCreatedTimeout = 5 * time.Minute
 
+	// Maximum amount of time to wait for bucket and key policy changes to propagate
+	publishingDestinationPropagationTimeout = 2 * time.Minute
+
 	// Maximum amount of time to wait for membership to propagate
 	// When removing Organization Admin Accounts, there is eventual
 	// consistency even after the account is no longer listed.
@@ -2,9 +2,12 @@ package iam
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
@@ -23,6 +26,7 @@ import (
 const (
 	policyNameMaxLen       = 128
 	policyNamePrefixMaxLen = policyNameMaxLen - resource.UniqueIDSuffixLength
+	policyVersionsMax      = 5
 )
 
 // @SDKResource("aws_iam_policy")
@@ -82,8 +86,17 @@ func ResourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"prune_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"version_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -275,6 +288,19 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("policy", policyToSet)
 
+	versions, err := policyListVersions(ctx, d.Id(), conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM policy (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("version_count", len(versions))
+
+	// Default prune_versions for imported resources and state written before the argument was added.
+	if rawState := d.GetRawState(); !rawState.IsNull() && rawState.GetAttr("prune_versions").IsNull() {
+		d.Set("prune_versions", true)
+	}
+
 	return diags
 }
 
@@ -282,9 +308,13 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
-		if err := policyPruneVersions(ctx, d.Id(), conn); err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating IAM policy %s: pruning versions: %s", d.Id(), err)
+	if d.HasChangesExcept("tags", "tags_all", "prune_versions") {
+		pruneVersions := d.Get("prune_versions").(bool)
+
+		if pruneVersions {
+			if err := policyPruneVersions(ctx, d.Id(), conn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM policy %s: pruning versions: %s", d.Id(), err)
+			}
 		}
 
 		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
@@ -298,7 +328,24 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			SetAsDefault:   aws.Bool(true),
 		}
 
-		if _, err := conn.CreatePolicyVersionWithContext(ctx, request); err != nil {
+		_, err = conn.CreatePolicyVersionWithContext(ctx, request)
+
+		// Another actor may have created versions since they were pruned.
+		if pruneVersions && tfawserr.ErrCodeEquals(err, iam.ErrCodeLimitExceededException) {
+			if err := policyPruneVersions(ctx, d.Id(), conn); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM policy %s: pruning versions: %s", d.Id(), err)
+			}
+
+			_, err = conn.CreatePolicyVersionWithContext(ctx, request)
+		}
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeLimitExceededException) {
+			if versions, listErr := policyListVersions(ctx, d.Id(), conn); listErr == nil {
+				err = policyVersionsLimitError(versions, err)
+			}
+		}
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM policy %s: %s", d.Id(), err)
 		}
 	}
@@ -353,29 +400,59 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 // least one more can be created before hitting the maximum of 5.
 //
 // The default version is never deleted.
-
 func policyPruneVersions(ctx context.Context, arn string, conn *iam.IAM) error {
 	versions, err := policyListVersions(ctx, arn, conn)
 	if err != nil {
 		return err
 	}
-	if len(versions) < 5 {
-		return nil
+
+	for len(versions) >= policyVersionsMax {
+		i := -1
+
+		for j, version := range versions {
+			if aws.BoolValue(version.IsDefaultVersion) {
+				continue
+			}
+			if i == -1 || aws.TimeValue(version.CreateDate).Before(aws.TimeValue(versions[i].CreateDate)) {
+				i = j
+			}
+		}
+
+		if i == -1 {
+			return policyVersionsLimitError(versions, nil)
+		}
+
+		if err := policyDeleteVersion(ctx, arn, aws.StringValue(versions[i].VersionId), conn); err != nil {
+			return err
+		}
+
+		versions = append(versions[:i], versions[i+1:]...)
 	}
 
-	var oldestVersion *iam.PolicyVersion
+	return nil
+}
+
+// policyVersionsLimitError returns an error listing a policy's versions when no more versions can be created.
+func policyVersionsLimitError(versions []*iam.PolicyVersion, err error) error {
+	var descriptions []string
 
 	for _, version := range versions {
-		if *version.IsDefaultVersion {
-			continue
-		}
-		if oldestVersion == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+		description := fmt.Sprintf("%s (created %s)", aws.StringValue(version.VersionId), aws.TimeValue(version.CreateDate).Format(time.RFC3339))
+
+		if aws.BoolValue(version.IsDefaultVersion) {
+			description += " (default)"
 		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	msg := fmt.Sprintf("maximum of %d versions reached and no version could be deleted to make room, existing versions: %s", policyVersionsMax, strings.Join(descriptions, ", "))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", msg, err)
 	}
 
-	return policyDeleteVersion(ctx, arn, aws.StringValue(oldestVersion.VersionId), conn)
+	return errors.New(msg)
 }
 
 func policyDeleteNonDefaultVersions(ctx context.Context, arn string, conn *iam.IAM) error {
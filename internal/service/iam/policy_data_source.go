@@ -61,6 +61,10 @@ func DataSourcePolicy() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"version_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -160,6 +164,14 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("policy", policyDocument)
 
+	versions, err := policyListVersions(ctx, policyArn, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Policy (%s) versions: %s", policyArn, err)
+	}
+
+	d.Set("version_count", len(versions))
+
 	return diags
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,6 +42,8 @@ func TestAccIAMPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "policy", expectedPolicyText),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(resourceName, "prune_versions", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version_count", "1"),
 				),
 			},
 			{
@@ -247,6 +250,54 @@ func TestAccIAMPolicy_policy(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_pruneVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out iam.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+	policyTemplate := `{"Statement":[{"Action":["ec2:Describe%d"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+
+	var steps []resource.TestStep
+	for i := 1; i <= 6; i++ {
+		expectedCount := i
+		if expectedCount > 5 {
+			expectedCount = 5
+		}
+
+		steps = append(steps, resource.TestStep{
+			Config: testAccPolicyConfig_pruneVersions(rName, fmt.Sprintf(policyTemplate, i), true),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckPolicyExists(ctx, resourceName, &out),
+				resource.TestCheckResourceAttr(resourceName, "policy", fmt.Sprintf(policyTemplate, i)),
+				resource.TestCheckResourceAttr(resourceName, "version_count", strconv.Itoa(expectedCount)),
+			),
+		})
+	}
+
+	steps = append(steps,
+		resource.TestStep{
+			Config: testAccPolicyConfig_pruneVersions(rName, fmt.Sprintf(policyTemplate, 6), false),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckPolicyExists(ctx, resourceName, &out),
+				resource.TestCheckResourceAttr(resourceName, "prune_versions", "false"),
+				resource.TestCheckResourceAttr(resourceName, "version_count", "5"),
+			),
+		},
+		resource.TestStep{
+			Config:      testAccPolicyConfig_pruneVersions(rName, fmt.Sprintf(policyTemplate, 7), false),
+			ExpectError: regexp.MustCompile(`maximum of 5 versions reached`),
+		},
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps:                    steps,
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/28833
 func TestAccIAMPolicy_diffs(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -493,6 +544,16 @@ resource "aws_iam_policy" "test" {
 `, rName, policy)
 }
 
+func testAccPolicyConfig_pruneVersions(rName, policy string, pruneVersions bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name           = %[1]q
+  policy         = %[2]q
+  prune_versions = %[3]t
+}
+`, rName, policy, pruneVersions)
+}
+
 func testAccPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
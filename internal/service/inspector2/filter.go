@@ -0,0 +1,954 @@
+package inspector2
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_filter")
+func ResourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.FilterAction](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id":                     stringFilterSchema(),
+						"component_id":                       stringFilterSchema(),
+						"component_type":                     stringFilterSchema(),
+						"ec2_instance_image_id":              stringFilterSchema(),
+						"ec2_instance_subnet_id":             stringFilterSchema(),
+						"ec2_instance_vpc_id":                stringFilterSchema(),
+						"ecr_image_architecture":             stringFilterSchema(),
+						"ecr_image_hash":                     stringFilterSchema(),
+						"ecr_image_pushed_at":                dateFilterSchema(),
+						"ecr_image_registry":                 stringFilterSchema(),
+						"ecr_image_repository_name":          stringFilterSchema(),
+						"ecr_image_tags":                     stringFilterSchema(),
+						"exploit_available":                  stringFilterSchema(),
+						"finding_arn":                        stringFilterSchema(),
+						"finding_status":                     stringFilterSchema(),
+						"finding_type":                       stringFilterSchema(),
+						"first_observed_at":                  dateFilterSchema(),
+						"fix_available":                      stringFilterSchema(),
+						"inspector_score":                    numberFilterSchema(),
+						"lambda_function_execution_role_arn": stringFilterSchema(),
+						"lambda_function_last_modified_at":   dateFilterSchema(),
+						"lambda_function_layers":             stringFilterSchema(),
+						"lambda_function_name":               stringFilterSchema(),
+						"lambda_function_runtime":            stringFilterSchema(),
+						"last_observed_at":                   dateFilterSchema(),
+						"network_protocol":                   stringFilterSchema(),
+						"port_range":                         portRangeFilterSchema(),
+						"related_vulnerabilities":            stringFilterSchema(),
+						"resource_id":                        stringFilterSchema(),
+						"resource_tags":                      mapFilterSchema(),
+						"resource_type":                      stringFilterSchema(),
+						"severity":                           stringFilterSchema(),
+						"title":                              stringFilterSchema(),
+						"updated_at":                         dateFilterSchema(),
+						"vendor_severity":                    stringFilterSchema(),
+						"vulnerability_id":                   stringFilterSchema(),
+						"vulnerability_source":               stringFilterSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ForceNewIfChange("description", func(_ context.Context, old, new, meta interface{}) bool {
+				// Any existing value cannot be cleared.
+				return new.(string) == ""
+			}),
+		),
+	}
+}
+
+func dateFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+				"start_inclusive": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidUTCTimestamp,
+				},
+			},
+		},
+	}
+}
+
+func mapFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.MapComparison](),
+				},
+				"key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+			},
+		},
+	}
+}
+
+func numberFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"lower_inclusive": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				"upper_inclusive": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func portRangeFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"begin_inclusive": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+				"end_inclusive": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+				},
+			},
+		},
+	}
+}
+
+func stringFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"comparison": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.StringComparison](),
+				},
+				"value": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameFilter = "Filter"
+)
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	in := &inspector2.CreateFilterInput{
+		Action: types.FilterAction(d.Get("action").(string)),
+		Name:   aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateFilter(ctx, in)
+
+	if err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionCreating, ResNameFilter, name, err)
+	}
+
+	if out == nil || out.Arn == nil {
+		return create.DiagError(names.Inspector2, create.ErrActionCreating, ResNameFilter, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Arn))
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	out, err := FindFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector V2 Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionReading, ResNameFilter, d.Id(), err)
+	}
+
+	d.Set("action", out.Action)
+	d.Set("arn", out.Arn)
+	d.Set("description", out.Description)
+	if out.Criteria != nil {
+		if err := d.Set("filter_criteria", []interface{}{flattenFilterCriteria(out.Criteria)}); err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionSetting, ResNameFilter, d.Id(), err)
+		}
+	} else {
+		d.Set("filter_criteria", nil)
+	}
+	d.Set("name", out.Name)
+
+	tags := KeyValueTags(ctx, out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionSetting, ResNameFilter, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Inspector2, create.ErrActionSetting, ResNameFilter, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &inspector2.UpdateFilterInput{
+			FilterArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			in.Action = types.FilterAction(d.Get("action").(string))
+		}
+
+		// Removing the description forces a new resource, as the API rejects an empty value.
+		if d.HasChange("description") {
+			if v, ok := d.GetOk("description"); ok {
+				in.Description = aws.String(v.(string))
+			}
+		}
+
+		if d.HasChange("filter_criteria") {
+			if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.FilterCriteria = expandFilterCriteria(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("name") {
+			in.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Inspector V2 Filter (%s)", d.Id())
+		_, err := conn.UpdateFilter(ctx, in)
+
+		if err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameFilter, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameFilter, d.Id(), err)
+		}
+	}
+
+	return resourceFilterRead(ctx, d, meta)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Client()
+
+	log.Printf("[INFO] Deleting Inspector V2 Filter %s", d.Id())
+
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.Inspector2, create.ErrActionDeleting, ResNameFilter, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*types.Filter, error) {
+	in := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	pages := inspector2.NewListFiltersPaginator(conn, in)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Filters {
+			if aws.ToString(v.Arn) == arn {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func expandFilterCriteria(tfMap map[string]interface{}) *types.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FilterCriteria{}
+
+	if v, ok := tfMap["aws_account_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AwsAccountId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["component_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_image_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceImageId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_subnet_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceSubnetId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ec2_instance_vpc_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Ec2InstanceVpcId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_architecture"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageArchitecture = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_hash"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageHash = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_pushed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImagePushedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_registry"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRegistry = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_repository_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageRepositoryName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["ecr_image_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EcrImageTags = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["exploit_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExploitAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_status"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingStatus = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["finding_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FindingType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["first_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FirstObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["fix_available"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FixAvailable = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["inspector_score"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.InspectorScore = expandNumberFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_execution_role_arn"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionExecutionRoleArn = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_last_modified_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLastModifiedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_layers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionLayers = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_name"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionName = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["lambda_function_runtime"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LambdaFunctionRuntime = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["last_observed_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LastObservedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["network_protocol"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.NetworkProtocol = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["port_range"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PortRange = expandPortRangeFilters(v.List())
+	}
+
+	if v, ok := tfMap["related_vulnerabilities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RelatedVulnerabilities = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_tags"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTags = expandMapFilters(v.List())
+	}
+
+	if v, ok := tfMap["resource_type"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceType = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["title"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Title = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["updated_at"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UpdatedAt = expandDateFilters(v.List())
+	}
+
+	if v, ok := tfMap["vendor_severity"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VendorSeverity = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_id"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilityId = expandStringFilters(v.List())
+	}
+
+	if v, ok := tfMap["vulnerability_source"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.VulnerabilitySource = expandStringFilters(v.List())
+	}
+
+	return apiObject
+}
+
+func expandDateFilters(tfList []interface{}) []types.DateFilter {
+	var apiObjects []types.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(t)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(t)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []interface{}) []types.MapFilter {
+	var apiObjects []types.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.MapFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.MapComparison(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilters(tfList []interface{}) []types.NumberFilter {
+	var apiObjects []types.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NumberFilter{}
+
+		if v, ok := tfMap["lower_inclusive"].(float64); ok {
+			apiObject.LowerInclusive = aws.Float64(v)
+		}
+
+		if v, ok := tfMap["upper_inclusive"].(float64); ok {
+			apiObject.UpperInclusive = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []interface{}) []types.PortRangeFilter {
+	var apiObjects []types.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.PortRangeFilter{}
+
+		if v, ok := tfMap["begin_inclusive"].(int); ok {
+			apiObject.BeginInclusive = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_inclusive"].(int); ok {
+			apiObject.EndInclusive = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStringFilters(tfList []interface{}) []types.StringFilter {
+	var apiObjects []types.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.StringFilter{}
+
+		if v, ok := tfMap["comparison"].(string); ok && v != "" {
+			apiObject.Comparison = types.StringComparison(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *types.FilterCriteria) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AwsAccountId; len(v) > 0 {
+		tfMap["aws_account_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ComponentId; len(v) > 0 {
+		tfMap["component_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ComponentType; len(v) > 0 {
+		tfMap["component_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceImageId; len(v) > 0 {
+		tfMap["ec2_instance_image_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceSubnetId; len(v) > 0 {
+		tfMap["ec2_instance_subnet_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Ec2InstanceVpcId; len(v) > 0 {
+		tfMap["ec2_instance_vpc_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageArchitecture; len(v) > 0 {
+		tfMap["ecr_image_architecture"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageHash; len(v) > 0 {
+		tfMap["ecr_image_hash"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImagePushedAt; len(v) > 0 {
+		tfMap["ecr_image_pushed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.EcrImageRegistry; len(v) > 0 {
+		tfMap["ecr_image_registry"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageRepositoryName; len(v) > 0 {
+		tfMap["ecr_image_repository_name"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.EcrImageTags; len(v) > 0 {
+		tfMap["ecr_image_tags"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ExploitAvailable; len(v) > 0 {
+		tfMap["exploit_available"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingArn; len(v) > 0 {
+		tfMap["finding_arn"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingStatus; len(v) > 0 {
+		tfMap["finding_status"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FindingType; len(v) > 0 {
+		tfMap["finding_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.FirstObservedAt; len(v) > 0 {
+		tfMap["first_observed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.FixAvailable; len(v) > 0 {
+		tfMap["fix_available"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.InspectorScore; len(v) > 0 {
+		tfMap["inspector_score"] = flattenNumberFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionExecutionRoleArn; len(v) > 0 {
+		tfMap["lambda_function_execution_role_arn"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionLastModifiedAt; len(v) > 0 {
+		tfMap["lambda_function_last_modified_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionLayers; len(v) > 0 {
+		tfMap["lambda_function_layers"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionName; len(v) > 0 {
+		tfMap["lambda_function_name"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LambdaFunctionRuntime; len(v) > 0 {
+		tfMap["lambda_function_runtime"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.LastObservedAt; len(v) > 0 {
+		tfMap["last_observed_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.NetworkProtocol; len(v) > 0 {
+		tfMap["network_protocol"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.PortRange; len(v) > 0 {
+		tfMap["port_range"] = flattenPortRangeFilters(v)
+	}
+
+	if v := apiObject.RelatedVulnerabilities; len(v) > 0 {
+		tfMap["related_vulnerabilities"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ResourceId; len(v) > 0 {
+		tfMap["resource_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.ResourceTags; len(v) > 0 {
+		tfMap["resource_tags"] = flattenMapFilters(v)
+	}
+
+	if v := apiObject.ResourceType; len(v) > 0 {
+		tfMap["resource_type"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Severity; len(v) > 0 {
+		tfMap["severity"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.Title; len(v) > 0 {
+		tfMap["title"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.UpdatedAt; len(v) > 0 {
+		tfMap["updated_at"] = flattenDateFilters(v)
+	}
+
+	if v := apiObject.VendorSeverity; len(v) > 0 {
+		tfMap["vendor_severity"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.VulnerabilityId; len(v) > 0 {
+		tfMap["vulnerability_id"] = flattenStringFilters(v)
+	}
+
+	if v := apiObject.VulnerabilitySource; len(v) > 0 {
+		tfMap["vulnerability_source"] = flattenStringFilters(v)
+	}
+
+	return tfMap
+}
+
+func flattenDateFilters(apiObjects []types.DateFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []types.MapFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+		}
+
+		if v := apiObject.Key; v != nil {
+			tfMap["key"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilters(apiObjects []types.NumberFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.LowerInclusive; v != nil {
+			tfMap["lower_inclusive"] = aws.ToFloat64(v)
+		}
+
+		if v := apiObject.UpperInclusive; v != nil {
+			tfMap["upper_inclusive"] = aws.ToFloat64(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []types.PortRangeFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BeginInclusive; v != nil {
+			tfMap["begin_inclusive"] = int(aws.ToInt32(v))
+		}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = int(aws.ToInt32(v))
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenStringFilters(apiObjects []types.StringFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"comparison": string(apiObject.Comparison),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = aws.ToString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -33,6 +33,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceEnabler,
 			TypeName: "aws_inspector2_enabler",
 		},
+		{
+			Factory:  ResourceFilter,
+			TypeName: "aws_inspector2_filter",
+		},
 		{
 			Factory:  ResourceOrganizationConfiguration,
 			TypeName: "aws_inspector2_organization_configuration",
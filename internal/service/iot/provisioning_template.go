@@ -2,6 +2,7 @@ package iot
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +24,11 @@ const (
 	provisioningHookPayloadVersion2020_04_01 = "2020-04-01"
 )
 
+const (
+	// A provisioning template can have at most 5 versions.
+	provisioningTemplateVersionsMax = 5
+)
+
 func provisioningHookPayloadVersion_Values() []string {
 	return []string{
 		provisioningHookPayloadVersion2020_04_01,
@@ -103,9 +110,25 @@ func ResourceProvisioningTemplate() *schema.Resource {
 					validation.StringLenBetween(0, 10240),
 				),
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(iot.TemplateType_Values(), false),
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("type").(string) == iot.TemplateTypeJitp && len(d.Get("pre_provisioning_hook").([]interface{})) > 0 {
+					return fmt.Errorf("pre_provisioning_hook cannot be configured for %s provisioning templates", iot.TemplateTypeJitp)
+				}
+
+				return nil
+			},
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -136,6 +159,10 @@ func resourceProvisioningTemplateCreate(ctx context.Context, d *schema.ResourceD
 		input.TemplateBody = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -187,6 +214,7 @@ func resourceProvisioningTemplateRead(ctx context.Context, d *schema.ResourceDat
 	}
 	d.Set("provisioning_role_arn", output.ProvisioningRoleArn)
 	d.Set("template_body", output.TemplateBody)
+	d.Set("type", output.Type)
 
 	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
 
@@ -212,6 +240,11 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).IoTConn()
 
 	if d.HasChange("template_body") {
+		// Make room for the new version by deleting the oldest non-default version, but only once the limit is reached.
+		if err := deleteProvisioningTemplateOldestVersion(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error deleting IoT Provisioning Template (%s) version: %s", d.Id(), err)
+		}
+
 		input := &iot.CreateProvisioningTemplateVersionInput{
 			SetAsDefault: aws.Bool(true),
 			TemplateBody: aws.String(d.Get("template_body").(string)),
@@ -226,7 +259,7 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if d.HasChanges("description", "enabled", "provisioning_role_arn") {
+	if d.HasChanges("description", "enabled", "pre_provisioning_hook", "provisioning_role_arn") {
 		input := &iot.UpdateProvisioningTemplateInput{
 			Description:         aws.String(d.Get("description").(string)),
 			Enabled:             aws.Bool(d.Get("enabled").(bool)),
@@ -234,6 +267,14 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 			TemplateName:        aws.String(d.Id()),
 		}
 
+		if d.HasChange("pre_provisioning_hook") {
+			if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PreProvisioningHook = expandProvisioningHook(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.RemovePreProvisioningHook = aws.Bool(true)
+			}
+		}
+
 		log.Printf("[DEBUG] Updating IoT Provisioning Template: %s", input)
 		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout,
 			func() (interface{}, error) {
@@ -276,6 +317,64 @@ func resourceProvisioningTemplateDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+func deleteProvisioningTemplateOldestVersion(ctx context.Context, conn *iot.IoT, name string) error {
+	input := &iot.ListProvisioningTemplateVersionsInput{
+		TemplateName: aws.String(name),
+	}
+	var count int
+	var oldest *iot.ProvisioningTemplateVersionSummary
+
+	err := conn.ListProvisioningTemplateVersionsPagesWithContext(ctx, input, func(page *iot.ListProvisioningTemplateVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Versions {
+			if v == nil {
+				continue
+			}
+
+			count++
+
+			if aws.BoolValue(v.IsDefaultVersion) {
+				continue
+			}
+
+			if oldest == nil || aws.Int64Value(v.VersionId) < aws.Int64Value(oldest.VersionId) {
+				oldest = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if count < provisioningTemplateVersionsMax || oldest == nil {
+		return nil
+	}
+
+	versionID := aws.Int64Value(oldest.VersionId)
+
+	log.Printf("[DEBUG] Deleting IoT Provisioning Template (%s) version: %d", name, versionID)
+	_, err = conn.DeleteProvisioningTemplateVersionWithContext(ctx, &iot.DeleteProvisioningTemplateVersionInput{
+		TemplateName: aws.String(name),
+		VersionId:    aws.Int64(versionID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting version %d: %w", versionID, err)
+	}
+
+	return nil
+}
+
 func flattenProvisioningHook(apiObject *iot.ProvisioningHook) map[string]interface{} {
 	if apiObject == nil {
 		return nil
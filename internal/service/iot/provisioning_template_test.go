@@ -3,6 +3,7 @@ package iot_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -40,6 +41,7 @@ func TestAccIoTProvisioningTemplate_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "provisioning_role_arn"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "template_body"),
+					resource.TestCheckResourceAttr(resourceName, "type", iot.TemplateTypeFleetProvisioning),
 				),
 			},
 			{
@@ -172,6 +174,81 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTProvisioningTemplate_versions(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "1"),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "2"),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "3"),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "4"),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 5),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "5"),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 5),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTProvisioningTemplate_jitpPreProvisioningHook(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName),
+				ExpectError: regexp.MustCompile(`pre_provisioning_hook cannot be configured for JITP provisioning templates`),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -423,3 +500,33 @@ resource "aws_iot_provisioning_template" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningTemplateConfig_jitpPreProvisioningHook(rName string) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  type                  = "JITP"
+
+  pre_provisioning_hook {
+    target_arn = "arn:${data.aws_partition.current.partition}:lambda:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:function:%[1]s"
+  }
+
+  template_body = jsonencode({
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "Active"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+    }
+  })
+}
+`, rName))
+}
@@ -37,79 +37,17 @@ func ResourceTopicRule() *schema.Resource {
 			"cloudwatch_alarm": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"alarm_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"state_reason": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"state_value": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
-						},
-					},
-				},
+				Elem:     cloudWatchAlarmActionSchema(),
 			},
 			"cloudwatch_logs": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"log_group_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     cloudWatchLogsActionSchema(),
 			},
 			"cloudwatch_metric": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"metric_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_namespace": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_timestamp": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: verify.ValidUTCTimestamp,
-						},
-						"metric_unit": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"metric_value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     cloudWatchMetricActionSchema(),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -118,112 +56,17 @@ func ResourceTopicRule() *schema.Resource {
 			"dynamodb": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"hash_key_field": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"hash_key_value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"hash_key_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"operation": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"DELETE",
-								"INSERT",
-								"UPDATE",
-							}, false),
-						},
-						"payload_field": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_field": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_value": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"range_key_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"table_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+				Elem:     dynamoDBActionSchema(),
 			},
 			"dynamodbv2": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"put_item": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"table_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     dynamoDBv2ActionSchema(),
 			},
 			"elasticsearch": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"endpoint": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validTopicRuleElasticsearchEndpoint,
-						},
-						"id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"index": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+				Elem:     elasticsearchActionSchema(),
 			},
 			"enabled": {
 				Type:     schema.TypeBool,
@@ -236,549 +79,143 @@ func ResourceTopicRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cloudwatch_alarm": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"alarm_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"state_reason": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"state_value": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         cloudWatchAlarmActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"cloudwatch_logs": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"log_group_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         cloudWatchLogsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"cloudwatch_metric": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"metric_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_namespace": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_timestamp": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidUTCTimestamp,
-									},
-									"metric_unit": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"metric_value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         cloudWatchMetricActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"dynamodb": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"hash_key_field": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"hash_key_value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"hash_key_type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"operation": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"DELETE",
-											"INSERT",
-											"UPDATE",
-										}, false),
-									},
-									"payload_field": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_field": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_value": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"range_key_type": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"table_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         dynamoDBActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"dynamodbv2": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"put_item": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"table_name": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         dynamoDBv2ActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"elasticsearch": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"endpoint": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validTopicRuleElasticsearchEndpoint,
-									},
-									"id": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"index": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"type": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         elasticsearchActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"firehose": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"delivery_stream_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"separator": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validTopicRuleFirehoseSeparator,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         firehoseActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"http": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"confirmation_url": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsURLWithHTTPS,
-									},
-									"http_header": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"key": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"value": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-									"url": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.IsURLWithHTTPS,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         httpActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"iot_analytics": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"channel_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         analyticsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"iot_events": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"input_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"message_id": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         eventsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"kafka": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"client_properties": {
-										Type:     schema.TypeMap,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"destination_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"key": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"partition": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"topic": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         kafkaActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"kinesis": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"partition_key": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"stream_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         kinesisActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"lambda": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"function_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         lambdaActionSchema(),
+							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
+						},
+						"location": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         locationActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"republish": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"qos": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      0,
-										ValidateFunc: validation.IntBetween(0, 1),
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"topic": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         republishActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"s3": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"bucket_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"canned_acl": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
-									},
-									"key": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         s3ActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"sns": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"message_format": {
-										Type:     schema.TypeString,
-										Default:  iot.MessageFormatRaw,
-										Optional: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"target_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         snsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"sqs": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"queue_url": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"use_base64": {
-										Type:     schema.TypeBool,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         sqsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"step_functions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"execution_name_prefix": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"state_machine_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         stepFunctionsActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 						"timestream": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"database_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"dimension": {
-										Type:     schema.TypeSet,
-										Required: true,
-										Elem:     timestreamDimensionResource,
-									},
-									"role_arn": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: verify.ValidARN,
-									},
-									"table_name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"timestamp": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"unit": {
-													Type:     schema.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"SECONDS",
-														"MILLISECONDS",
-														"MICROSECONDS",
-														"NANOSECONDS",
-													}, false),
-												},
-												"value": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-											},
-										},
-									},
-								},
-							},
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         timestreamActionSchema(),
 							ExactlyOneOf: topicRuleErrorActionExactlyOneOf,
 						},
 					},
@@ -787,381 +224,672 @@ func ResourceTopicRule() *schema.Resource {
 			"firehose": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"delivery_stream_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"separator": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validTopicRuleFirehoseSeparator,
-						},
-					},
-				},
+				Elem:     firehoseActionSchema(),
 			},
 			"http": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"confirmation_url": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
-						},
-						"http_header": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"key": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
-						"url": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.IsURLWithHTTPS,
-						},
-					},
-				},
+				Elem:     httpActionSchema(),
 			},
 			"iot_analytics": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"channel_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     analyticsActionSchema(),
 			},
 			"iot_events": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"input_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"message_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     eventsActionSchema(),
 			},
 			"kafka": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"client_properties": {
-							Type:     schema.TypeMap,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"destination_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"key": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"partition": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"topic": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+				Elem:     kafkaActionSchema(),
 			},
 			"kinesis": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"partition_key": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"stream_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+				Elem:     kinesisActionSchema(),
 			},
 			"lambda": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"function_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+				Elem:     lambdaActionSchema(),
+			},
+			"location": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     locationActionSchema(),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTopicRuleName,
+			},
+			"republish": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     republishActionSchema(),
+			},
+			"s3": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     s3ActionSchema(),
+			},
+			"sns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     snsActionSchema(),
+			},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sql_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sqs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     sqsActionSchema(),
+			},
+			"step_functions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     stepFunctionsActionSchema(),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"timestream": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     timestreamActionSchema(),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var topicRuleErrorActionExactlyOneOf = []string{
+	"error_action.0.cloudwatch_alarm",
+	"error_action.0.cloudwatch_logs",
+	"error_action.0.cloudwatch_metric",
+	"error_action.0.dynamodb",
+	"error_action.0.dynamodbv2",
+	"error_action.0.elasticsearch",
+	"error_action.0.firehose",
+	"error_action.0.http",
+	"error_action.0.iot_analytics",
+	"error_action.0.iot_events",
+	"error_action.0.kafka",
+	"error_action.0.kinesis",
+	"error_action.0.lambda",
+	"error_action.0.location",
+	"error_action.0.republish",
+	"error_action.0.s3",
+	"error_action.0.sns",
+	"error_action.0.sqs",
+	"error_action.0.step_functions",
+	"error_action.0.timestream",
+}
+
+var timestreamDimensionResource *schema.Resource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+func cloudWatchAlarmActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"alarm_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state_value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validTopicRuleCloudWatchAlarmStateValue,
+			},
+		},
+	}
+}
+
+func cloudWatchLogsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"log_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func cloudWatchMetricActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"metric_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dynamoDBActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hash_key_field": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hash_key_value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"hash_key_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"operation": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DELETE",
+					"INSERT",
+					"UPDATE",
+				}, false),
+			},
+			"payload_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"range_key_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"range_key_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"range_key_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dynamoDBv2ActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"put_item": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func elasticsearchActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validTopicRuleElasticsearchEndpoint,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"index": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func firehoseActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"delivery_stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"separator": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validTopicRuleFirehoseSeparator,
+			},
+		},
+	}
+}
+
+func httpActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"confirmation_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"http_header": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+		},
+	}
+}
+
+func analyticsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"channel_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func eventsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"input_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func kafkaActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"client_properties": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destination_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"partition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"topic": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func kinesisActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"partition_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func lambdaActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func locationActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"latitude": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"longitude": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"timestamp": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "MILLISECONDS",
+							ValidateFunc: validation.StringInSlice([]string{
+								"SECONDS",
+								"MILLISECONDS",
+								"MICROSECONDS",
+								"NANOSECONDS",
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tracker_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func republishActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"qos": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 1),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"topic": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func s3ActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"canned_acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func snsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"message_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iot.MessageFormatRaw,
 			},
-			"name": {
+			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validTopicRuleName,
+				ValidateFunc: verify.ValidARN,
 			},
-			"republish": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"qos": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      0,
-							ValidateFunc: validation.IntBetween(0, 1),
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"topic": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+			"target_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
 			},
-			"s3": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"bucket_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"canned_acl": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(iot.CannedAccessControlList_Values(), false),
-						},
-						"key": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
+		},
+	}
+}
+
+func sqsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
 			},
-			"sns": {
-				Type:     schema.TypeSet,
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"use_base64": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func stepFunctionsActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"execution_name_prefix": {
+				Type:     schema.TypeString,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"message_format": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  iot.MessageFormatRaw,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"target_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-					},
-				},
 			},
-			"sql": {
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"state_machine_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"sql_version": {
+		},
+	}
+}
+
+func timestreamActionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"database_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			"sqs": {
+			"dimension": {
 				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"queue_url": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"use_base64": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-					},
-				},
+				Required: true,
+				Elem:     timestreamDimensionResource,
 			},
-			"step_functions": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"execution_name_prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"state_machine_name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-					},
-				},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
-			"timestream": {
-				Type:     schema.TypeSet,
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeList,
 				Optional: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"database_name": {
+						"unit": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SECONDS",
+								"MILLISECONDS",
+								"MICROSECONDS",
+								"NANOSECONDS",
+							}, false),
 						},
-						"dimension": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Elem:     timestreamDimensionResource,
-						},
-						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: verify.ValidARN,
-						},
-						"table_name": {
+						"value": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
-						"timestamp": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"unit": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											"SECONDS",
-											"MILLISECONDS",
-											"MICROSECONDS",
-											"NANOSECONDS",
-										}, false),
-									},
-									"value": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-								},
-							},
-						},
 					},
 				},
 			},
 		},
-
-		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
-var topicRuleErrorActionExactlyOneOf = []string{
-	"error_action.0.cloudwatch_alarm",
-	"error_action.0.cloudwatch_logs",
-	"error_action.0.cloudwatch_metric",
-	"error_action.0.dynamodb",
-	"error_action.0.dynamodbv2",
-	"error_action.0.elasticsearch",
-	"error_action.0.firehose",
-	"error_action.0.http",
-	"error_action.0.iot_analytics",
-	"error_action.0.iot_events",
-	"error_action.0.kafka",
-	"error_action.0.kinesis",
-	"error_action.0.lambda",
-	"error_action.0.republish",
-	"error_action.0.s3",
-	"error_action.0.sns",
-	"error_action.0.sqs",
-	"error_action.0.step_functions",
-	"error_action.0.timestream",
-}
-
-var timestreamDimensionResource *schema.Resource = &schema.Resource{
-	Schema: map[string]*schema.Schema{
-		"name": {
-			Type:     schema.TypeString,
-			Required: true,
-		},
-		"value": {
-			Type:     schema.TypeString,
-			Required: true,
-		},
-	},
-}
-
 func resourceTopicRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IoTConn()
@@ -1268,6 +996,10 @@ func resourceTopicRuleRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting lambda: %s", err)
 	}
 
+	if err := d.Set("location", flattenLocationActions(output.Rule.Actions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting location: %s", err)
+	}
+
 	if err := d.Set("republish", flattenRepublishActions(output.Rule.Actions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting republish: %s", err)
 	}
@@ -1728,6 +1460,52 @@ func expandLambdaAction(tfList []interface{}) *iot.LambdaAction {
 	return apiObject
 }
 
+func expandLocationAction(tfList []interface{}) *iot.LocationAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iot.LocationAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latitude"].(string); ok && v != "" {
+		apiObject.Latitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["longitude"].(string); ok && v != "" {
+		apiObject.Longitude = aws.String(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		timestamp := &iot.LocationTimestamp{}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			timestamp.Unit = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			timestamp.Value = aws.String(v)
+		}
+
+		apiObject.Timestamp = timestamp
+	}
+
+	if v, ok := tfMap["tracker_name"].(string); ok && v != "" {
+		apiObject.TrackerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandRepublishAction(tfList []interface{}) *iot.RepublishAction {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -2068,6 +1846,17 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 		actions = append(actions, &iot.Action{Lambda: action})
 	}
 
+	// Legacy root attribute handling
+	for _, tfMapRaw := range d.Get("location").(*schema.Set).List() {
+		action := expandLocationAction([]interface{}{tfMapRaw})
+
+		if action == nil {
+			continue
+		}
+
+		actions = append(actions, &iot.Action{Location: action})
+	}
+
 	// Legacy root attribute handling
 	for _, tfMapRaw := range d.Get("republish").(*schema.Set).List() {
 		action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2274,6 +2063,16 @@ func expandTopicRulePayload(d *schema.ResourceData) *iot.TopicRulePayload {
 
 					iotErrorAction = &iot.Action{Lambda: action}
 				}
+			case "location":
+				for _, tfMapRaw := range v.([]interface{}) {
+					action := expandLocationAction([]interface{}{tfMapRaw})
+
+					if action == nil {
+						continue
+					}
+
+					iotErrorAction = &iot.Action{Location: action}
+				}
 			case "republish":
 				for _, tfMapRaw := range v.([]interface{}) {
 					action := expandRepublishAction([]interface{}{tfMapRaw})
@@ -2918,6 +2717,67 @@ func flattenPutItemInput(apiObject *iot.PutItemInput) []interface{} {
 	return []interface{}{tfMap}
 }
 
+// Legacy root attribute handling
+func flattenLocationActions(actions []*iot.Action) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		if v := action.Location; v != nil {
+			results = append(results, flattenLocationAction(v)...)
+		}
+	}
+
+	return results
+}
+
+func flattenLocationAction(apiObject *iot.LocationAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DeviceId; v != nil {
+		tfMap["device_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Latitude; v != nil {
+		tfMap["latitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Longitude; v != nil {
+		tfMap["longitude"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap["role_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		timestamp := make(map[string]interface{})
+
+		if v := v.Unit; v != nil {
+			timestamp["unit"] = aws.StringValue(v)
+		}
+
+		if v := v.Value; v != nil {
+			timestamp["value"] = aws.StringValue(v)
+		}
+
+		tfMap["timestamp"] = []interface{}{timestamp}
+	}
+
+	if v := apiObject.TrackerName; v != nil {
+		tfMap["tracker_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 // Legacy root attribute handling
 func flattenRepublishActions(actions []*iot.Action) []interface{} {
 	results := make([]interface{}, 0)
@@ -3267,6 +3127,10 @@ func flattenErrorAction(errorAction *iot.Action) []map[string]interface{} {
 		results = append(results, map[string]interface{}{"lambda": flattenLambdaActions(input)})
 		return results
 	}
+	if errorAction.Location != nil {
+		results = append(results, map[string]interface{}{"location": flattenLocationActions(input)})
+		return results
+	}
 	if errorAction.Republish != nil {
 		results = append(results, map[string]interface{}{"republish": flattenRepublishActions(input)})
 		return results
@@ -186,7 +186,7 @@ func resourceTopicRuleDestinationUpdate(ctx context.Context, d *schema.ResourceD
 			return diag.Errorf("updating IoT Topic Rule Destination (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waiter(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waiter(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("waiting for IoT Topic Rule Destination (%s) update: %s", d.Id(), err)
 		}
 	}
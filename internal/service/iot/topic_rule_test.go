@@ -1041,6 +1041,45 @@ func TestAccIoTTopicRule_lambda(t *testing.T) {
 	})
 }
 
+func TestAccIoTTopicRule_location(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := testAccTopicRuleName()
+	resourceName := "aws_iot_topic_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iot.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicRuleConfig_location(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "error_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "error_action.0.location.0.tracker_name", "errortracker"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "location.*", map[string]string{
+						"device_id":         "${get(*, 'device')}",
+						"latitude":          "${get(get(*, 'location'), 'lat')}",
+						"longitude":         "${get(get(*, 'location'), 'long')}",
+						"timestamp.#":       "1",
+						"timestamp.0.unit":  "MILLISECONDS",
+						"timestamp.0.value": "${timestamp()}",
+						"tracker_name":      "mytracker",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccIoTTopicRule_republish(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := testAccTopicRuleName()
@@ -2088,6 +2127,42 @@ resource "aws_iot_topic_rule" "test" {
 `, rName)
 }
 
+func testAccTopicRuleConfig_location(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTopicRuleConfig_destinationRole(rName),
+		fmt.Sprintf(`
+resource "aws_iot_topic_rule" "test" {
+  name        = %[1]q
+  enabled     = true
+  sql         = "SELECT * FROM 'topic/test'"
+  sql_version = "2015-10-08"
+
+  location {
+    device_id    = "$${get(*, 'device')}"
+    latitude     = "$${get(get(*, 'location'), 'lat')}"
+    longitude    = "$${get(get(*, 'location'), 'long')}"
+    role_arn     = aws_iam_role.test.arn
+    tracker_name = "mytracker"
+
+    timestamp {
+      unit  = "MILLISECONDS"
+      value = "$${timestamp()}"
+    }
+  }
+
+  error_action {
+    location {
+      device_id    = "$${get(*, 'device')}"
+      latitude     = "$${get(get(*, 'location'), 'lat')}"
+      longitude    = "$${get(get(*, 'location'), 'long')}"
+      role_arn     = aws_iam_role.test.arn
+      tracker_name = "errortracker"
+    }
+  }
+}
+`, rName))
+}
+
 func testAccTopicRuleConfig_republish(rName string) string {
 	return acctest.ConfigCompose(
 		testAccTopicRuleConfig_destinationRole(rName),
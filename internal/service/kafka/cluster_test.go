@@ -751,6 +751,43 @@ func TestAccKafkaCluster_Info_revision(t *testing.T) {
 	})
 }
 
+func TestAccKafkaCluster_Info_latestRevision(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 kafka.ClusterInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	configurationResourceName := "aws_msk_configuration.test"
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kafka.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_configurationInfoLatestRevision(rName, "86400000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "configuration_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_info.0.arn", configurationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_info.0.revision", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_configurationInfoLatestRevision(rName, "172800000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "configuration_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_info.0.arn", configurationResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "configuration_info.0.revision", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_info.0.revision", configurationResourceName, "latest_revision"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKafkaCluster_EncryptionInfo_encryptionAtRestKMSKeyARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster kafka.ClusterInfo
@@ -1850,6 +1887,37 @@ resource "aws_msk_cluster" "test" {
 `, rName))
 }
 
+func testAccClusterConfig_configurationInfoLatestRevision(rName, retentionMs string) string {
+	return acctest.ConfigCompose(testAccClusterBaseConfig(rName), fmt.Sprintf(`
+resource "aws_msk_configuration" "test" {
+  kafka_versions = ["2.7.1"]
+  name           = %[1]q
+
+  server_properties = <<PROPERTIES
+log.cleaner.delete.retention.ms = %[2]s
+PROPERTIES
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.7.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = [aws_subnet.example_subnet_az1.id, aws_subnet.example_subnet_az2.id, aws_subnet.example_subnet_az3.id]
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.example_sg.id]
+  }
+
+  configuration_info {
+    arn      = aws_msk_configuration.test.arn
+    revision = aws_msk_configuration.test.latest_revision
+  }
+}
+`, rName, retentionMs))
+}
+
 func testAccClusterConfig_configurationInfoRevision2(rName string) string {
 	return acctest.ConfigCompose(testAccClusterBaseConfig(rName), fmt.Sprintf(`
 resource "aws_msk_configuration" "test1" {
@@ -838,6 +838,27 @@ func ResourceApplication() *schema.Resource {
 				},
 			},
 
+			"application_maintenance_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_maintenance_window_end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"application_maintenance_window_start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a valid time in the format HH:MM"),
+						},
+					},
+				},
+			},
+
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -895,6 +916,11 @@ func ResourceApplication() *schema.Resource {
 				),
 			},
 
+			"rollback_on_failure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"runtime_environment": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -966,6 +992,12 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 	// CreateTimestamp is required for deletion, so persist to state now in case of subsequent errors and destroy being called without refresh.
 	d.Set("create_timestamp", aws.TimeValue(output.ApplicationDetail.CreateTimestamp).Format(time.RFC3339))
 
+	if v, ok := d.GetOk("application_maintenance_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateApplicationMaintenanceConfiguration(ctx, conn, applicationName, v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Kinesis Analytics v2 Application (%s): %s", applicationName, err)
+		}
+	}
+
 	if _, ok := d.GetOk("start_application"); ok {
 		if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating Kinesis Analytics v2 Application (%s): %s", applicationName, err)
@@ -1008,6 +1040,10 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting application_configuration: %s", err)
 	}
 
+	if err := d.Set("application_maintenance_configuration", flattenApplicationMaintenanceConfigurationDescription(application.ApplicationMaintenanceConfigurationDescription)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_maintenance_configuration: %s", err)
+	}
+
 	if err := d.Set("cloudwatch_logging_options", flattenCloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cloudwatch_logging_options: %s", err)
 	}
@@ -1098,7 +1134,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1137,7 +1173,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 								output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputProcessingConfigurationOutput)
 
-								if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+								if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 									return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 								}
 
@@ -1162,7 +1198,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 								output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationOutput)
 
-								if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+								if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 									return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 								}
 
@@ -1224,7 +1260,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationOutputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1251,7 +1287,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationOutputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1282,7 +1318,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationReferenceDataSourceOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1309,7 +1345,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationReferenceDataSourceOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1350,7 +1386,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 					output := outputRaw.(*kinesisanalyticsv2.AddApplicationVpcConfigurationOutput)
 
-					if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 					}
 
@@ -1377,7 +1413,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 					output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationVpcConfigurationOutput)
 
-					if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 					}
 
@@ -1436,7 +1472,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 				output := outputRaw.(*kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionOutput)
 
-				if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 				}
 
@@ -1463,7 +1499,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 				output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionOutput)
 
-				if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 				}
 
@@ -1503,12 +1539,20 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
 			}
 
-			if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := waitApplicationUpdatedOrRolledBack(ctx, conn, d, applicationName); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 			}
 		}
 	}
 
+	if d.HasChange("application_maintenance_configuration") {
+		if v, ok := d.GetOk("application_maintenance_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateApplicationMaintenanceConfiguration(ctx, conn, applicationName, v.([]interface{})[0].(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		arn := d.Get("arn").(string)
 		o, n := d.GetChange("tags_all")
@@ -1651,6 +1695,82 @@ func stopApplication(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyt
 	return nil
 }
 
+// rollbackApplication reverts a failed update to the previous running version of the application.
+func rollbackApplication(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName string, timeout time.Duration) error {
+	application, err := FindApplicationDetailByName(ctx, conn, applicationName)
+
+	if err != nil {
+		return fmt.Errorf("rolling back application: %w", err)
+	}
+
+	input := &kinesisanalyticsv2.RollbackApplicationInput{
+		ApplicationName:             aws.String(applicationName),
+		CurrentApplicationVersionId: application.ApplicationVersionId,
+	}
+
+	log.Printf("[DEBUG] Rolling back Kinesis Analytics v2 Application (%s): %s", aws.StringValue(application.ApplicationARN), input)
+
+	if _, err := conn.RollbackApplicationWithContext(ctx, input); err != nil {
+		return fmt.Errorf("rolling back application: %w", err)
+	}
+
+	if _, err := waitApplicationRolledBack(ctx, conn, applicationName, timeout); err != nil {
+		return fmt.Errorf("rolling back application: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+func updateApplicationMaintenanceConfiguration(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName string, tfMap map[string]interface{}) error {
+	input := &kinesisanalyticsv2.UpdateApplicationMaintenanceConfigurationInput{
+		ApplicationMaintenanceConfigurationUpdate: &kinesisanalyticsv2.ApplicationMaintenanceConfigurationUpdate{
+			ApplicationMaintenanceWindowStartTimeUpdate: aws.String(tfMap["application_maintenance_window_start_time"].(string)),
+		},
+		ApplicationName: aws.String(applicationName),
+	}
+
+	log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s) maintenance configuration: %s", applicationName, input)
+
+	if _, err := conn.UpdateApplicationMaintenanceConfigurationWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating maintenance configuration: %w", err)
+	}
+
+	return nil
+}
+
+// waitApplicationUpdatedOrRolledBack waits for an update to complete.
+// If the update fails and rollback_on_failure is set, the application is rolled back to its previous version.
+func waitApplicationUpdatedOrRolledBack(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, d *schema.ResourceData, applicationName string) error {
+	_, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate))
+
+	if err == nil {
+		return nil
+	}
+
+	hint := applicationLogStreamHint(d.Get("cloudwatch_logging_options").([]interface{}))
+
+	if d.Get("rollback_on_failure").(bool) {
+		if rollbackErr := rollbackApplication(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); rollbackErr != nil {
+			return fmt.Errorf("%s%s: %w", err, hint, rollbackErr)
+		}
+	}
+
+	return fmt.Errorf("%w%s", err, hint)
+}
+
+// applicationLogStreamHint points at the application's CloudWatch log stream, which records the cause of a failed update.
+func applicationLogStreamHint(tfList []interface{}) string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["log_stream_arn"].(string); ok && v != "" {
+		return fmt.Sprintf(" (see CloudWatch log stream %s for the failure cause)", v)
+	}
+
+	return ""
+}
+
 func expandApplicationConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
 	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
 		return nil
@@ -2830,6 +2950,19 @@ func flattenApplicationConfigurationDescription(applicationConfigurationDescript
 	return []interface{}{mApplicationConfiguration}
 }
 
+func flattenApplicationMaintenanceConfigurationDescription(apiObject *kinesisanalyticsv2.ApplicationMaintenanceConfigurationDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"application_maintenance_window_end_time":   aws.StringValue(apiObject.ApplicationMaintenanceWindowEndTime),
+		"application_maintenance_window_start_time": aws.StringValue(apiObject.ApplicationMaintenanceWindowStartTime),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenCloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
 	if len(cloudWatchLoggingOptionDescriptions) == 0 || cloudWatchLoggingOptionDescriptions[0] == nil {
 		return []interface{}{}
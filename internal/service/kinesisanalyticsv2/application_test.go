@@ -3,9 +3,11 @@ package kinesisanalyticsv2_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,6 +18,52 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestApplicationLogStreamHint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    []interface{}
+		Expected string
+	}{
+		{
+			TestName: "no logging options",
+			Input:    []interface{}{},
+			Expected: "",
+		},
+		{
+			TestName: "nil logging options",
+			Input:    []interface{}{nil},
+			Expected: "",
+		},
+		{
+			TestName: "empty log stream",
+			Input: []interface{}{map[string]interface{}{
+				"log_stream_arn": "",
+			}},
+			Expected: "",
+		},
+		{
+			TestName: "log stream",
+			Input: []interface{}{map[string]interface{}{
+				"log_stream_arn": "arn:aws:logs:us-west-2:123456789012:log-group:test:log-stream:test", //lintignore:AWSAT003,AWSAT005
+			}},
+			Expected: " (see CloudWatch log stream arn:aws:logs:us-west-2:123456789012:log-group:test:log-stream:test for the failure cause)", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfkinesisanalyticsv2.ApplicationLogStreamHint(testCase.Input), testCase.Expected; got != want {
+				t.Errorf("ApplicationLogStreamHint() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestAccKinesisAnalyticsV2Application_basicFlinkApplication(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v kinesisanalyticsv2.ApplicationDetail
@@ -1343,6 +1391,132 @@ func TestAccKinesisAnalyticsV2Application_FlinkApplication_restoreFromSnapshot(t
 	})
 }
 
+func TestAccKinesisAnalyticsV2Application_FlinkApplication_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_maintenanceConfiguration(rName, "03:00"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_maintenance_configuration.0.application_maintenance_window_end_time"),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.0.application_maintenance_window_start_time", "03:00"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollback_on_failure"},
+			},
+			{
+				Config: testAccApplicationConfig_maintenanceConfiguration(rName, "22:30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "application_maintenance_configuration.0.application_maintenance_window_end_time"),
+					resource.TestCheckResourceAttr(resourceName, "application_maintenance_configuration.0.application_maintenance_window_start_time", "22:30"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisAnalyticsV2Application_FlinkApplication_rollbackOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	s3Object1ResourceName := "aws_s3_object.test.0"
+	s3Object2ResourceName := "aws_s3_object.test.1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_rollbackOnFailure(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.0.file_key", s3Object1ResourceName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollback_on_failure", "start_application"},
+			},
+			{
+				Config: testAccApplicationConfig_rollbackOnFailure(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.0.file_key", s3Object2ResourceName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisAnalyticsV2Application_FlinkApplication_rollbackOnFailureUpdateFails(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	s3ObjectResourceName := "aws_s3_object.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_rollbackOnFailure(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+				),
+			},
+			{
+				Config:      testAccApplicationConfig_rollbackOnFailureInvalidCode(rName),
+				ExpectError: regexp.MustCompile(`waiting for Kinesis Analytics v2 Application .* to update`),
+			},
+			{
+				Config: testAccApplicationConfig_rollbackOnFailure(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					testAccCheckApplicationRolledBack(&v),
+					resource.TestCheckResourceAttrPair(resourceName, "application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location.0.file_key", s3ObjectResourceName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKinesisAnalyticsV2Application_FlinkApplicationStartApplication_onCreate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v kinesisanalyticsv2.ApplicationDetail
@@ -4174,6 +4348,16 @@ func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
+func testAccCheckApplicationRolledBack(v *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.ApplicationVersionRolledBackFrom == nil {
+			return fmt.Errorf("Kinesis Analytics v2 Application (%s) was not rolled back", aws.StringValue(v.ApplicationName))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckApplicationExists(ctx context.Context, n string, v *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -5046,6 +5230,97 @@ resource "aws_kinesisanalyticsv2_application_snapshot" "test" {
 `, rName, forceStop))
 }
 
+func testAccApplicationConfig_maintenanceConfiguration(rName, startTime string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_15"
+  service_execution_role = aws_iam_role.test[0].arn
+  rollback_on_failure    = true
+
+  application_maintenance_configuration {
+    application_maintenance_window_start_time = %[2]q
+  }
+}
+`, rName, startTime))
+}
+
+func testAccApplicationConfig_rollbackOnFailure(rName string, objectIndex int) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
+		testAccApplicationConfigBaseFlinkApplication(rName),
+		fmt.Sprintf(`
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_8"
+  service_execution_role = aws_iam_role.test[0].arn
+  rollback_on_failure    = true
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn     = aws_s3_bucket.test.arn
+          file_key       = aws_s3_object.test[%[2]d].key
+          object_version = aws_s3_object.test[%[2]d].version_id
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+
+    application_snapshot_configuration {
+      snapshots_enabled = false
+    }
+  }
+
+  start_application = true
+}
+`, rName, objectIndex))
+}
+
+func testAccApplicationConfig_rollbackOnFailureInvalidCode(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
+		testAccApplicationConfigBaseFlinkApplication(rName),
+		fmt.Sprintf(`
+resource "aws_s3_object" "invalid" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s.invalid"
+  content = "not a Flink application"
+}
+
+resource "aws_kinesisanalyticsv2_application" "test" {
+  name                   = %[1]q
+  runtime_environment    = "FLINK-1_8"
+  service_execution_role = aws_iam_role.test[0].arn
+  rollback_on_failure    = true
+
+  application_configuration {
+    application_code_configuration {
+      code_content {
+        s3_content_location {
+          bucket_arn     = aws_s3_bucket.test.arn
+          file_key       = aws_s3_object.invalid.key
+          object_version = aws_s3_object.invalid.version_id
+        }
+      }
+
+      code_content_type = "ZIPFILE"
+    }
+
+    application_snapshot_configuration {
+      snapshots_enabled = false
+    }
+  }
+
+  start_application = true
+}
+`, rName))
+}
+
 func testAccApplicationConfig_sqlConfigurationNotSpecified(rName string) string {
 	return acctest.ConfigCompose(
 		testAccApplicationConfig_baseServiceExecutionIAMRole(rName),
@@ -0,0 +1,6 @@
+package kinesisanalyticsv2
+
+// Exports for use in tests only.
+var (
+	ApplicationLogStreamHint = applicationLogStreamHint
+)
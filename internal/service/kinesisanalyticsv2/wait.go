@@ -28,6 +28,24 @@ func waitApplicationDeleted(ctx context.Context, conn *kinesisanalyticsv2.Kinesi
 	return nil, err
 }
 
+// waitApplicationRolledBack waits for an Application rollback to complete
+func waitApplicationRolledBack(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusRollingBack, kinesisanalyticsv2.ApplicationStatusUpdating},
+		Target:  []string{kinesisanalyticsv2.ApplicationStatusReady, kinesisanalyticsv2.ApplicationStatusRolledBack, kinesisanalyticsv2.ApplicationStatusRunning},
+		Refresh: statusApplication(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
 // waitApplicationStarted waits for an Application to start
 func waitApplicationStarted(ctx context.Context, conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) (*kinesisanalyticsv2.ApplicationDetail, error) {
 	stateConf := &resource.StateChangeConf{
@@ -3,6 +3,7 @@ package kms
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -38,22 +40,79 @@ func ResourceCustomKeyStore() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cloud_hsm_cluster_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"xks_proxy_authentication_credential", "xks_proxy_connectivity", "xks_proxy_uri_endpoint", "xks_proxy_uri_path", "xks_proxy_vpc_endpoint_service_name"},
+			},
+			"connection_state": {
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Computed: true,
 			},
 			"custom_key_store_name": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"custom_key_store_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(kms.CustomKeyStoreType_Values(), false)),
+			},
 			"key_store_password": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(7, 32)),
+				RequiredWith:     []string{"cloud_hsm_cluster_id"},
 			},
 			"trust_anchor_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"cloud_hsm_cluster_id"},
+			},
+			"wait_for_connection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"xks_proxy_authentication_credential": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(20, 30)),
+						},
+						"raw_secret_access_key": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(43, 64)),
+						},
+					},
+				},
+			},
+			"xks_proxy_connectivity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(kms.XksProxyConnectivityType_Values(), false)),
+			},
+			"xks_proxy_uri_endpoint": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+			},
+			"xks_proxy_uri_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"xks_proxy_vpc_endpoint_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 		},
 	}
@@ -67,10 +126,43 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).KMSConn()
 
 	in := &kms.CreateCustomKeyStoreInput{
-		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
-		CustomKeyStoreName:     aws.String(d.Get("custom_key_store_name").(string)),
-		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
-		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+		CustomKeyStoreName: aws.String(d.Get("custom_key_store_name").(string)),
+	}
+
+	if v, ok := d.GetOk("cloud_hsm_cluster_id"); ok {
+		in.CloudHsmClusterId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_key_store_type"); ok {
+		in.CustomKeyStoreType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_store_password"); ok {
+		in.KeyStorePassword = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("trust_anchor_certificate"); ok {
+		in.TrustAnchorCertificate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_connectivity"); ok {
+		in.XksProxyConnectivity = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_endpoint"); ok {
+		in.XksProxyUriEndpoint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_uri_path"); ok {
+		in.XksProxyUriPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("xks_proxy_vpc_endpoint_service_name"); ok {
+		in.XksProxyVpcEndpointServiceName = aws.String(v.(string))
 	}
 
 	out, err := conn.CreateCustomKeyStoreWithContext(ctx, in)
@@ -84,6 +176,12 @@ func resourceCustomKeyStoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(out.CustomKeyStoreId))
 
+	if d.Get("wait_for_connection").(bool) {
+		if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.KMS, create.ErrActionCreating, ResNameCustomKeyStore, d.Id(), err)
+		}
+	}
+
 	return resourceCustomKeyStoreRead(ctx, d, meta)
 }
 
@@ -106,39 +204,111 @@ func resourceCustomKeyStoreRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	d.Set("cloud_hsm_cluster_id", out.CloudHsmClusterId)
+	d.Set("connection_state", out.ConnectionState)
 	d.Set("custom_key_store_name", out.CustomKeyStoreName)
+	d.Set("custom_key_store_type", out.CustomKeyStoreType)
 	d.Set("trust_anchor_certificate", out.TrustAnchorCertificate)
 
+	if v := out.XksProxyConfiguration; v != nil {
+		d.Set("xks_proxy_connectivity", v.Connectivity)
+		d.Set("xks_proxy_uri_endpoint", v.UriEndpoint)
+		d.Set("xks_proxy_uri_path", v.UriPath)
+		d.Set("xks_proxy_vpc_endpoint_service_name", v.VpcEndpointServiceName)
+	} else {
+		d.Set("xks_proxy_connectivity", nil)
+		d.Set("xks_proxy_uri_endpoint", nil)
+		d.Set("xks_proxy_uri_path", nil)
+		d.Set("xks_proxy_vpc_endpoint_service_name", nil)
+	}
+
 	return nil
 }
 
 func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KMSConn()
 
-	update := false
-
-	in := &kms.UpdateCustomKeyStoreInput{
-		CustomKeyStoreId:  aws.String(d.Id()),
-		CloudHsmClusterId: aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+	if d.HasChangesExcept("wait_for_connection") {
+		in := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("custom_key_store_name") {
+			in.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		}
+
+		if d.HasChange("key_store_password") {
+			in.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+		}
+
+		if d.HasChange("xks_proxy_authentication_credential") {
+			if v, ok := d.GetOk("xks_proxy_authentication_credential"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				in.XksProxyAuthenticationCredential = expandXksProxyAuthenticationCredential(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("xks_proxy_connectivity") {
+			in.XksProxyConnectivity = aws.String(d.Get("xks_proxy_connectivity").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_endpoint") {
+			in.XksProxyUriEndpoint = aws.String(d.Get("xks_proxy_uri_endpoint").(string))
+		}
+
+		if d.HasChange("xks_proxy_uri_path") {
+			in.XksProxyUriPath = aws.String(d.Get("xks_proxy_uri_path").(string))
+		}
+
+		if d.HasChange("xks_proxy_vpc_endpoint_service_name") {
+			in.XksProxyVpcEndpointServiceName = aws.String(d.Get("xks_proxy_vpc_endpoint_service_name").(string))
+		}
+
+		// An external key store's name, proxy URI path and proxy authentication credential can be
+		// updated while the key store is connected. Any other change, and any change to an AWS
+		// CloudHSM key store, requires the key store to be disconnected first.
+		disconnect := true
+
+		if d.Get("custom_key_store_type").(string) == kms.CustomKeyStoreTypeExternalKeyStore {
+			disconnect = d.HasChangesExcept("custom_key_store_name", "wait_for_connection", "xks_proxy_authentication_credential", "xks_proxy_uri_path")
+		}
+
+		reconnect := false
+
+		if disconnect && d.Get("connection_state").(string) == kms.ConnectionStateTypeConnected {
+			if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+			}
+
+			reconnect = true
+		}
+
+		_, err := conn.UpdateCustomKeyStoreWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		}
+
+		if reconnect && !d.Get("wait_for_connection").(bool) {
+			if _, err := conn.ConnectCustomKeyStoreWithContext(ctx, &kms.ConnectCustomKeyStoreInput{
+				CustomKeyStoreId: aws.String(d.Id()),
+			}); err != nil {
+				return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+			}
+		}
 	}
 
-	if d.HasChange("key_store_password") {
-		in.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
-		update = true
-	}
-
-	if d.HasChange("custom_key_store_name") {
-		in.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
-		update = true
-	}
+	if d.Get("wait_for_connection").(bool) {
+		out, err := FindCustomKeyStoreByID(ctx, conn, &kms.DescribeCustomKeyStoresInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		})
 
-	if !update {
-		return nil
-	}
+		if err != nil {
+			return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		}
 
-	_, err := conn.UpdateCustomKeyStoreWithContext(ctx, in)
-	if err != nil {
-		return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+		if aws.StringValue(out.ConnectionState) != kms.ConnectionStateTypeConnected {
+			if err := connectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.KMS, create.ErrActionUpdating, ResNameCustomKeyStore, d.Id(), err)
+			}
+		}
 	}
 
 	return resourceCustomKeyStoreRead(ctx, d, meta)
@@ -147,15 +317,87 @@ func resourceCustomKeyStoreUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceCustomKeyStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KMSConn()
 
+	// A custom key store must be disconnected before it can be deleted.
+	if d.Get("connection_state").(string) != kms.ConnectionStateTypeDisconnected {
+		if err := disconnectCustomKeyStore(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if tfresource.NotFound(err) {
+				return nil
+			}
+
+			return create.DiagError(names.KMS, create.ErrActionDeleting, ResNameCustomKeyStore, d.Id(), err)
+		}
+	}
+
 	log.Printf("[INFO] Deleting KMS CustomKeyStore %s", d.Id())
 
 	_, err := conn.DeleteCustomKeyStoreWithContext(ctx, &kms.DeleteCustomKeyStoreInput{
 		CustomKeyStoreId: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
 		return nil
 	}
 
+	if err != nil {
+		return create.DiagError(names.KMS, create.ErrActionDeleting, ResNameCustomKeyStore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func connectCustomKeyStore(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) error {
+	_, err := conn.ConnectCustomKeyStoreWithContext(ctx, &kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("connecting: %w", err)
+	}
+
+	if _, err := WaitCustomKeyStoreConnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for connection: %w", err)
+	}
+
+	return nil
+}
+
+func disconnectCustomKeyStore(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) error {
+	_, err := conn.DisconnectCustomKeyStoreWithContext(ctx, &kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreNotFoundException) {
+		return &resource.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	// Disconnecting a key store that isn't connected returns CustomKeyStoreInvalidStateException.
+	if err != nil && !tfawserr.ErrCodeEquals(err, kms.ErrCodeCustomKeyStoreInvalidStateException) {
+		return fmt.Errorf("disconnecting: %w", err)
+	}
+
+	if _, err := WaitCustomKeyStoreDisconnected(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for disconnection: %w", err)
+	}
+
 	return nil
 }
+
+func expandXksProxyAuthenticationCredential(tfMap map[string]interface{}) *kms.XksProxyAuthenticationCredentialType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kms.XksProxyAuthenticationCredentialType{}
+
+	if v, ok := tfMap["access_key_id"].(string); ok && v != "" {
+		apiObject.AccessKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_secret_access_key"].(string); ok && v != "" {
+		apiObject.RawSecretAccessKey = aws.String(v)
+	}
+
+	return apiObject
+}
@@ -56,13 +56,15 @@ func testAccCustomKeyStore_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
 					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterId),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", "DISCONNECTED"),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", "AWS_CLOUDHSM"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"key_store_password"},
+				ImportStateVerifyIgnore: []string{"key_store_password", "wait_for_connection"},
 			},
 		},
 	})
@@ -154,6 +156,59 @@ func testAccCustomKeyStore_disappears(t *testing.T) {
 	})
 }
 
+func testAccCustomKeyStore_externalKeyStore(t *testing.T) {
+	ctx := acctest.Context(t)
+	envVars := []string{"XKS_PROXY_URI_ENDPOINT", "XKS_PROXY_URI_PATH", "XKS_PROXY_ACCESS_KEY_ID", "XKS_PROXY_SECRET_ACCESS_KEY"}
+	for _, v := range envVars {
+		if os.Getenv(v) == "" {
+			t.Skipf("%s environment variable not set", v)
+		}
+	}
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var customkeystore kms.CustomKeyStoresListEntry
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_custom_key_store.test"
+
+	uriEndpoint := os.Getenv("XKS_PROXY_URI_ENDPOINT")
+	uriPath := os.Getenv("XKS_PROXY_URI_PATH")
+	accessKeyID := os.Getenv("XKS_PROXY_ACCESS_KEY_ID")
+	secretAccessKey := os.Getenv("XKS_PROXY_SECRET_ACCESS_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, kms.EndpointsID)
+			testAccCustomKeyStoresPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, kms.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomKeyStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomKeyStoreExists(ctx, resourceName, &customkeystore),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", "CONNECTED"),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_type", "EXTERNAL_KEY_STORE"),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_connectivity", "PUBLIC_ENDPOINT"),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_endpoint", uriEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "xks_proxy_uri_path", uriPath),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_connection", "xks_proxy_authentication_credential"},
+			},
+		},
+	})
+}
+
 func testAccCheckCustomKeyStoreDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
@@ -233,3 +288,22 @@ resource "aws_kms_custom_key_store" "test" {
 }
 `, rName, clusterId, anchorCertificate)
 }
+
+func testAccCustomKeyStoreConfig_externalKeyStore(rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  custom_key_store_name = %[1]q
+  custom_key_store_type = "EXTERNAL_KEY_STORE"
+  wait_for_connection   = true
+
+  xks_proxy_connectivity = "PUBLIC_ENDPOINT"
+  xks_proxy_uri_endpoint = %[2]q
+  xks_proxy_uri_path     = %[3]q
+
+  xks_proxy_authentication_credential {
+    access_key_id         = %[4]q
+    raw_secret_access_key = %[5]q
+  }
+}
+`, rName, uriEndpoint, uriPath, accessKeyID, secretAccessKey)
+}
@@ -11,9 +11,10 @@ func TestAccKMS_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"CustomKeyStore": {
-			"basic":      testAccCustomKeyStore_basic,
-			"update":     testAccCustomKeyStore_update,
-			"disappears": testAccCustomKeyStore_disappears,
+			"basic":            testAccCustomKeyStore_basic,
+			"update":           testAccCustomKeyStore_update,
+			"disappears":       testAccCustomKeyStore_disappears,
+			"externalKeyStore": testAccCustomKeyStore_externalKeyStore,
 		},
 	}
 
@@ -24,3 +24,22 @@ func StatusKeyState(ctx context.Context, conn *kms.KMS, id string) resource.Stat
 		return output, aws.StringValue(output.KeyState), nil
 	}
 }
+
+func StatusCustomKeyStoreConnectionState(ctx context.Context, conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		in := &kms.DescribeCustomKeyStoresInput{
+			CustomKeyStoreId: aws.String(id),
+		}
+		output, err := FindCustomKeyStoreByID(ctx, conn, in)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionState), nil
+	}
+}
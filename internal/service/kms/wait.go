@@ -2,6 +2,7 @@ package kms
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -247,3 +248,45 @@ func WaitReplicaKeyCreated(ctx context.Context, conn *kms.KMS, id string) (*kms.
 
 	return nil, err
 }
+
+func WaitCustomKeyStoreConnected(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnecting, kms.ConnectionStateTypeDisconnected},
+		Target:  []string{kms.ConnectionStateTypeConnected},
+		Refresh: StatusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		if aws.StringValue(output.ConnectionState) == kms.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCustomKeyStoreDisconnected(ctx context.Context, conn *kms.KMS, id string, timeout time.Duration) (*kms.CustomKeyStoresListEntry, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.ConnectionStateTypeConnected, kms.ConnectionStateTypeConnecting, kms.ConnectionStateTypeDisconnecting},
+		Target:  []string{kms.ConnectionStateTypeDisconnected},
+		Refresh: StatusCustomKeyStoreConnectionState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok {
+		if aws.StringValue(output.ConnectionState) == kms.ConnectionStateTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionErrorCode)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
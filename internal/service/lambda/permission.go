@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -105,6 +106,8 @@ func ResourcePermission() *schema.Resource {
 				ConflictsWith: []string{"statement_id"},
 			},
 		},
+
+		CustomizeDiff: resourcePermissionCustomizeDiff,
 	}
 }
 
@@ -156,7 +159,17 @@ func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Retry for IAM and Lambda eventual consistency.
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout,
 		func() (interface{}, error) {
-			return conn.AddPermissionWithContext(ctx, input)
+			output, err := conn.AddPermissionWithContext(ctx, input)
+
+			// A statement with the same ID but different content may have been left behind,
+			// e.g. when the function was recreated outside of Terraform. Replace it.
+			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "already exists") {
+				if err := removeConflictingPermission(ctx, conn, input); err != nil {
+					return nil, err
+				}
+			}
+
+			return output, err
 		},
 		lambda.ErrCodeResourceConflictException, lambda.ErrCodeResourceNotFoundException)
 
@@ -281,6 +294,95 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourcePermissionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_account") || !diff.NewValueKnown("source_arn") {
+		return nil
+	}
+
+	sourceAccount, sourceARN := diff.Get("source_account").(string), diff.Get("source_arn").(string)
+
+	if sourceAccount == "" || sourceARN == "" {
+		return nil
+	}
+
+	parsedARN, err := arn.Parse(sourceARN)
+
+	if err != nil {
+		return nil
+	}
+
+	// Not all source ARNs include an account ID (e.g. S3 buckets), and the account ID may be a wildcard.
+	if accountID := parsedARN.AccountID; accountID != "" && !strings.ContainsAny(accountID, "*?") && accountID != sourceAccount {
+		return fmt.Errorf("source_account (%s) does not match the account ID (%s) in source_arn (%s)", sourceAccount, accountID, sourceARN)
+	}
+
+	return nil
+}
+
+// removeConflictingPermission removes the existing policy statement with the input's statement ID
+// if its content differs from the input. Identical statements, such as another resource
+// using the same statement ID, are left in place.
+func removeConflictingPermission(ctx context.Context, conn *lambda.Lambda, input *lambda.AddPermissionInput) error {
+	functionName, statementID, qualifier := aws.StringValue(input.FunctionName), aws.StringValue(input.StatementId), aws.StringValue(input.Qualifier)
+
+	statement, err := FindPolicyStatementByTwoPartKey(ctx, conn, functionName, statementID, qualifier)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if policyStatementMatchesPermissionInput(statement, input) {
+		return nil
+	}
+
+	log.Printf("[INFO] Removing conflicting Lambda Permission (%s/%s)", functionName, statementID)
+	_, err = conn.RemovePermissionWithContext(ctx, &lambda.RemovePermissionInput{
+		FunctionName: input.FunctionName,
+		Qualifier:    input.Qualifier,
+		StatementId:  input.StatementId,
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func policyStatementMatchesPermissionInput(statement *PolicyStatement, input *lambda.AddPermissionInput) bool {
+	if statement.Action != aws.StringValue(input.Action) {
+		return false
+	}
+
+	var principal interface{}
+	if v, ok := statement.Principal.(map[string]interface{}); ok {
+		if _, ok := v["AWS"]; ok {
+			principal = v["AWS"]
+		} else {
+			principal = v["Service"]
+		}
+	} else {
+		principal = statement.Principal
+	}
+
+	// Account ID principals are returned as IAM root user ARNs.
+	if v, ok := principal.(string); !ok || (v != aws.StringValue(input.Principal) && !strings.HasSuffix(v, ":"+aws.StringValue(input.Principal)+":root")) {
+		return false
+	}
+
+	stringEquals, arnLike := statement.Condition["StringEquals"], statement.Condition["ArnLike"]
+
+	return stringEquals["AWS:SourceAccount"] == aws.StringValue(input.SourceAccount) &&
+		stringEquals["lambda:EventSourceToken"] == aws.StringValue(input.EventSourceToken) &&
+		stringEquals["aws:PrincipalOrgID"] == aws.StringValue(input.PrincipalOrgID) &&
+		stringEquals["lambda:FunctionUrlAuthType"] == aws.StringValue(input.FunctionUrlAuthType) &&
+		arnLike["AWS:SourceArn"] == aws.StringValue(input.SourceArn)
+}
+
 func findPolicy(ctx context.Context, conn *lambda.Lambda, input *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
 	output, err := conn.GetPolicyWithContext(ctx, input)
 
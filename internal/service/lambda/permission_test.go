@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -310,6 +311,66 @@ func TestAccLambdaPermission_statementIDDuplicate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaPermission_statementIDConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionConfig_base(rName),
+			},
+			{
+				// Leave behind a statement with the same ID but a different principal.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
+
+					_, err := conn.AddPermissionWithContext(ctx, &lambda.AddPermissionInput{
+						Action:       aws.String("lambda:InvokeFunction"),
+						FunctionName: aws.String(rName),
+						Principal:    aws.String("sns.amazonaws.com"),
+						StatementId:  aws.String("AllowExecutionFromCloudWatch"),
+					})
+
+					if err != nil {
+						t.Fatalf("adding Lambda Permission: %s", err)
+					}
+				},
+				Config: testAccPermissionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionExists(ctx, resourceName, &statement),
+					resource.TestCheckResourceAttr(resourceName, "principal", "events.amazonaws.com"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "AllowExecutionFromCloudWatch"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermission_sourceAccountMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPermissionConfig_sourceAccountMismatch(rName),
+				ExpectError: regexp.MustCompile(`does not match the account ID \(111111111111\) in source_arn`),
+			},
+		},
+	})
+}
+
 func TestAccLambdaPermission_rawFunctionName(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -833,6 +894,18 @@ resource "aws_lambda_permission" "test2" {
 `)
 }
 
+func testAccPermissionConfig_sourceAccountMismatch(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  action         = "lambda:InvokeFunction"
+  function_name  = aws_lambda_function.test.arn
+  principal      = "events.amazonaws.com"
+  source_account = "222222222222"
+  source_arn     = "arn:aws:events:us-west-2:111111111111:rule/*"
+}
+`)
+}
+
 func testAccPermissionConfig_rawFunctionName(rName string) string {
 	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
 resource "aws_lambda_permission" "test" {
@@ -0,0 +1,538 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_geofence")
+func ResourceGeofence() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeofenceCreate,
+		ReadWithoutTimeout:   resourceGeofenceRead,
+		UpdateWithoutTimeout: resourceGeofenceUpdate,
+		DeleteWithoutTimeout: resourceGeofenceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGeofenceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"geofence_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"geometry": geofenceGeometrySchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameGeofence = "Geofence"
+)
+
+const (
+	// BatchPutGeofence and BatchDeleteGeofence accept at most 10 entries per request.
+	geofenceBatchSize = 10
+	// A polygon geometry can have at most 1,000 vertices across all of its linear rings.
+	geofencePolygonMaxVertices = 1000
+
+	geofenceStatusDeleted  = "DELETED"
+	geofenceStatusDeleting = "DELETING"
+)
+
+func geofenceGeometrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"circle": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							// The [longitude, latitude] pair is validated by validateGeofenceGeometry.
+							"center": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 2,
+								MaxItems: 2,
+								Elem:     &schema.Schema{Type: schema.TypeFloat},
+							},
+							"radius": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								ValidateFunc: validation.FloatBetween(0, 100000),
+							},
+						},
+					},
+				},
+				"polygon": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validGeofencePolygon,
+					StateFunc: func(v interface{}) string {
+						polygon, _ := normalizeGeofencePolygon(v.(string))
+						return polygon
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGeofenceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr("geometry").IsWhollyKnown() {
+		return nil
+	}
+
+	if err := validateGeofenceGeometry(d.Get("geometry").([]interface{})); err != nil {
+		return fmt.Errorf("geometry: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGeofenceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	collectionName := d.Get("collection_name").(string)
+	geofenceID := d.Get("geofence_id").(string)
+
+	geometry, err := expandGeofenceGeometry(d.Get("geometry").([]interface{}))
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofence, geofenceID, err)
+	}
+
+	entries := []*locationservice.BatchPutGeofenceRequestEntry{{
+		GeofenceId: aws.String(geofenceID),
+		Geometry:   geometry,
+	}}
+
+	if err := putGeofences(ctx, conn, collectionName, entries); err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofence, geofenceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", collectionName, geofenceID))
+
+	return resourceGeofenceRead(ctx, d, meta)
+}
+
+func resourceGeofenceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	collectionName, geofenceID, err := GeofenceParseID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofence, d.Id(), err)
+	}
+
+	out, err := FindGeofenceByTwoPartKey(ctx, conn, collectionName, geofenceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Geofence (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofence, d.Id(), err)
+	}
+
+	d.Set("collection_name", collectionName)
+	d.Set("create_time", aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	d.Set("geofence_id", out.GeofenceId)
+
+	geometry, err := flattenGeofenceGeometry(out.Geometry)
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofence, d.Id(), err)
+	}
+
+	if err := d.Set("geometry", geometry); err != nil {
+		return create.DiagError(names.Location, create.ErrActionSetting, ResNameGeofence, d.Id(), err)
+	}
+
+	d.Set("status", out.Status)
+	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceGeofenceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	if d.HasChange("geometry") {
+		geometry, err := expandGeofenceGeometry(d.Get("geometry").([]interface{}))
+
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofence, d.Id(), err)
+		}
+
+		entries := []*locationservice.BatchPutGeofenceRequestEntry{{
+			GeofenceId: aws.String(d.Get("geofence_id").(string)),
+			Geometry:   geometry,
+		}}
+
+		if err := putGeofences(ctx, conn, d.Get("collection_name").(string), entries); err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofence, d.Id(), err)
+		}
+	}
+
+	return resourceGeofenceRead(ctx, d, meta)
+}
+
+func resourceGeofenceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	log.Printf("[INFO] Deleting Location Geofence %s", d.Id())
+
+	err := deleteGeofences(ctx, conn, d.Get("collection_name").(string), []string{d.Get("geofence_id").(string)})
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionDeleting, ResNameGeofence, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindGeofenceByTwoPartKey(ctx context.Context, conn *locationservice.LocationService, collectionName, geofenceID string) (*locationservice.GetGeofenceOutput, error) {
+	in := &locationservice.GetGeofenceInput{
+		CollectionName: aws.String(collectionName),
+		GeofenceId:     aws.String(geofenceID),
+	}
+
+	out, err := conn.GetGeofenceWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Geometry == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Status); status == geofenceStatusDeleted || status == geofenceStatusDeleting {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+func GeofenceParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "|")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("please make sure the ID is in the form COLLECTIONNAME|GEOFENCEID")
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// putGeofences stores the specified geofences in batches, returning an error for any entry the API rejects.
+func putGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, entries []*locationservice.BatchPutGeofenceRequestEntry) error {
+	var errs *multierror.Error
+
+	for i := 0; i < len(entries); i += geofenceBatchSize {
+		j := i + geofenceBatchSize
+		if j > len(entries) {
+			j = len(entries)
+		}
+
+		out, err := conn.BatchPutGeofenceWithContext(ctx, &locationservice.BatchPutGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			Entries:        entries[i:j],
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// deleteGeofences removes the specified geofences in batches, ignoring geofences that no longer exist.
+func deleteGeofences(ctx context.Context, conn *locationservice.LocationService, collectionName string, geofenceIDs []string) error {
+	var errs *multierror.Error
+
+	for i := 0; i < len(geofenceIDs); i += geofenceBatchSize {
+		j := i + geofenceBatchSize
+		if j > len(geofenceIDs) {
+			j = len(geofenceIDs)
+		}
+
+		out, err := conn.BatchDeleteGeofenceWithContext(ctx, &locationservice.BatchDeleteGeofenceInput{
+			CollectionName: aws.String(collectionName),
+			GeofenceIds:    aws.StringSlice(geofenceIDs[i:j]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range out.Errors {
+			if aws.StringValue(v.Error.Code) == locationservice.BatchItemErrorCodeResourceNotFoundError {
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("geofence (%s): %s: %s", aws.StringValue(v.GeofenceId), aws.StringValue(v.Error.Code), aws.StringValue(v.Error.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// parseGeofencePolygon parses a JSON array of linear rings, each an array of [longitude, latitude] positions,
+// and verifies that each ring is closed and that the polygon is within the service limits.
+func parseGeofencePolygon(v string) ([][][]float64, error) {
+	var polygon [][][]float64
+
+	if err := json.Unmarshal([]byte(v), &polygon); err != nil {
+		return nil, fmt.Errorf("must be a JSON array of linear rings: %w", err)
+	}
+
+	if len(polygon) == 0 {
+		return nil, errors.New("must contain at least one linear ring")
+	}
+
+	vertices := 0
+
+	for i, ring := range polygon {
+		if len(ring) < 4 {
+			return nil, fmt.Errorf("linear ring %d must have at least 4 vertices, got %d", i, len(ring))
+		}
+
+		for j, position := range ring {
+			if len(position) != 2 {
+				return nil, fmt.Errorf("linear ring %d vertex %d must be a [longitude, latitude] pair", i, j)
+			}
+
+			if lon, lat := position[0], position[1]; lon < -180 || lon > 180 || lat < -90 || lat > 90 {
+				return nil, fmt.Errorf("linear ring %d vertex %d (%v, %v) is not a valid [longitude, latitude] pair", i, j, lon, lat)
+			}
+		}
+
+		if first, last := ring[0], ring[len(ring)-1]; first[0] != last[0] || first[1] != last[1] {
+			return nil, fmt.Errorf("linear ring %d is not closed; its first and last vertices must be the same", i)
+		}
+
+		vertices += len(ring)
+	}
+
+	if vertices > geofencePolygonMaxVertices {
+		return nil, fmt.Errorf("must have at most %d vertices, got %d", geofencePolygonMaxVertices, vertices)
+	}
+
+	return polygon, nil
+}
+
+func normalizeGeofencePolygon(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	var polygon [][][]float64
+
+	if err := json.Unmarshal([]byte(v), &polygon); err != nil {
+		return v, err
+	}
+
+	b, err := json.Marshal(polygon)
+
+	if err != nil {
+		return v, err
+	}
+
+	return string(b), nil
+}
+
+// validateGeofenceGeometry checks the geometry constraints that the schema can't express:
+// exactly one of circle or polygon is set, and a circle's center is a valid [longitude, latitude] pair.
+func validateGeofenceGeometry(tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	circle, _ := tfMap["circle"].([]interface{})
+	hasCircle := len(circle) > 0 && circle[0] != nil
+	polygon, _ := tfMap["polygon"].(string)
+
+	if hasCircle == (polygon != "") {
+		return errors.New("exactly one of circle or polygon must be specified")
+	}
+
+	if !hasCircle {
+		return nil
+	}
+
+	if center, _ := circle[0].(map[string]interface{})["center"].([]interface{}); len(center) == 2 {
+		if lon, lat := center[0].(float64), center[1].(float64); lon < -180 || lon > 180 || lat < -90 || lat > 90 {
+			return fmt.Errorf("circle center (%v, %v) is not a valid [longitude, latitude] pair", lon, lat)
+		}
+	}
+
+	return nil
+}
+
+func validGeofencePolygon(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseGeofencePolygon(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q %w", k, err))
+	}
+
+	return
+}
+
+func expandGeofenceGeometry(tfList []interface{}) (*locationservice.GeofenceGeometry, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, errors.New("geometry is required")
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &locationservice.GeofenceGeometry{}
+
+	if v, ok := tfMap["circle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		circle := v[0].(map[string]interface{})
+		apiObject.Circle = &locationservice.Circle{
+			Radius: aws.Float64(circle["radius"].(float64)),
+		}
+
+		for _, v := range circle["center"].([]interface{}) {
+			apiObject.Circle.Center = append(apiObject.Circle.Center, aws.Float64(v.(float64)))
+		}
+	}
+
+	if v, ok := tfMap["polygon"].(string); ok && v != "" {
+		polygon, err := parseGeofencePolygon(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ring := range polygon {
+			var apiRing [][]*float64
+
+			for _, position := range ring {
+				apiRing = append(apiRing, aws.Float64Slice(position))
+			}
+
+			apiObject.Polygon = append(apiObject.Polygon, apiRing)
+		}
+	}
+
+	if (apiObject.Circle == nil) == (apiObject.Polygon == nil) {
+		return nil, errors.New("exactly one of geometry.circle or geometry.polygon must be specified")
+	}
+
+	return apiObject, nil
+}
+
+func flattenGeofenceGeometry(apiObject *locationservice.GeofenceGeometry) ([]interface{}, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Circle; v != nil {
+		tfMap["circle"] = []interface{}{map[string]interface{}{
+			"center": aws.Float64ValueSlice(v.Center),
+			"radius": aws.Float64Value(v.Radius),
+		}}
+	}
+
+	if v := apiObject.Polygon; v != nil {
+		var polygon [][][]float64
+
+		for _, ring := range v {
+			var tfRing [][]float64
+
+			for _, position := range ring {
+				tfRing = append(tfRing, aws.Float64ValueSlice(position))
+			}
+
+			polygon = append(polygon, tfRing)
+		}
+
+		b, err := json.Marshal(polygon)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["polygon"] = string(b)
+	}
+
+	return []interface{}{tfMap}, nil
+}
@@ -0,0 +1,313 @@
+package location
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_location_geofence_batch")
+func ResourceGeofenceBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGeofenceBatchCreate,
+		ReadWithoutTimeout:   resourceGeofenceBatchRead,
+		UpdateWithoutTimeout: resourceGeofenceBatchUpdate,
+		DeleteWithoutTimeout: resourceGeofenceBatchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGeofenceBatchCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"geofence": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"geofence_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"geometry": geofenceGeometrySchema(),
+					},
+				},
+				Set: geofenceBatchEntryHash,
+			},
+		},
+	}
+}
+
+const (
+	ResNameGeofenceBatch = "Geofence Batch"
+)
+
+// geofenceBatchEntryHash hashes the normalized polygon, as set hashes are computed
+// from the configured value before the polygon's StateFunc is applied.
+func geofenceBatchEntryHash(v interface{}) int {
+	var buf bytes.Buffer
+	tfMap := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["geofence_id"].(string)))
+
+	if v, ok := tfMap["geometry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		geometry := v[0].(map[string]interface{})
+
+		if v, ok := geometry["circle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			circle := v[0].(map[string]interface{})
+			buf.WriteString(fmt.Sprintf("%v-%v-", circle["center"], circle["radius"]))
+		}
+
+		if v, ok := geometry["polygon"].(string); ok {
+			polygon, _ := normalizeGeofencePolygon(v)
+			buf.WriteString(fmt.Sprintf("%s-", polygon))
+		}
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+func resourceGeofenceBatchCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.GetAttr("geofence").IsWhollyKnown() {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("geofence").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if err := validateGeofenceGeometry(tfMap["geometry"].([]interface{})); err != nil {
+			return fmt.Errorf("geofence (%s) geometry: %w", tfMap["geofence_id"].(string), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGeofenceBatchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	collectionName := d.Get("collection_name").(string)
+
+	entries, err := expandGeofenceBatchEntries(d.Get("geofence").(*schema.Set).List())
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofenceBatch, collectionName, err)
+	}
+
+	if err := putGeofences(ctx, conn, collectionName, entries); err != nil {
+		return create.DiagError(names.Location, create.ErrActionCreating, ResNameGeofenceBatch, collectionName, err)
+	}
+
+	d.SetId(collectionName)
+
+	return resourceGeofenceBatchRead(ctx, d, meta)
+}
+
+func resourceGeofenceBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	geofences, err := FindGeofencesByCollectionName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Geofence Batch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofenceBatch, d.Id(), err)
+	}
+
+	// Only track the geofences managed by this resource, unless importing.
+	managed := make(map[string]bool)
+	for _, tfMapRaw := range d.Get("geofence").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			managed[tfMap["geofence_id"].(string)] = true
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, v := range geofences {
+		geofenceID := aws.StringValue(v.GeofenceId)
+
+		if len(managed) > 0 && !managed[geofenceID] {
+			continue
+		}
+
+		geometry, err := flattenGeofenceGeometry(v.Geometry)
+
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionReading, ResNameGeofenceBatch, d.Id(), err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"geofence_id": geofenceID,
+			"geometry":    geometry,
+		})
+	}
+
+	d.Set("collection_name", d.Id())
+	if err := d.Set("geofence", tfList); err != nil {
+		return create.DiagError(names.Location, create.ErrActionSetting, ResNameGeofenceBatch, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceGeofenceBatchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	if d.HasChange("geofence") {
+		o, n := d.GetChange("geofence")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Geofences whose geometry changed appear in both the removed and the added sets.
+		// They are overwritten by BatchPutGeofence, so only delete IDs no longer configured.
+		entries, err := expandGeofenceBatchEntries(ns.Difference(os).List())
+
+		if err != nil {
+			return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofenceBatch, d.Id(), err)
+		}
+
+		configured := make(map[string]bool)
+		for _, v := range ns.List() {
+			configured[v.(map[string]interface{})["geofence_id"].(string)] = true
+		}
+
+		var removed []string
+		for _, v := range os.Difference(ns).List() {
+			if geofenceID := v.(map[string]interface{})["geofence_id"].(string); !configured[geofenceID] {
+				removed = append(removed, geofenceID)
+			}
+		}
+
+		if len(removed) > 0 {
+			if err := deleteGeofences(ctx, conn, d.Id(), removed); err != nil {
+				return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofenceBatch, d.Id(), err)
+			}
+		}
+
+		if len(entries) > 0 {
+			if err := putGeofences(ctx, conn, d.Id(), entries); err != nil {
+				return create.DiagError(names.Location, create.ErrActionUpdating, ResNameGeofenceBatch, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceGeofenceBatchRead(ctx, d, meta)
+}
+
+func resourceGeofenceBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn()
+
+	log.Printf("[INFO] Deleting Location Geofence Batch %s", d.Id())
+
+	var geofenceIDs []string
+	for _, v := range d.Get("geofence").(*schema.Set).List() {
+		geofenceIDs = append(geofenceIDs, v.(map[string]interface{})["geofence_id"].(string))
+	}
+
+	if len(geofenceIDs) == 0 {
+		return nil
+	}
+
+	if err := deleteGeofences(ctx, conn, d.Id(), geofenceIDs); err != nil {
+		return create.DiagError(names.Location, create.ErrActionDeleting, ResNameGeofenceBatch, d.Id(), err)
+	}
+
+	return nil
+}
+
+// FindGeofencesByCollectionName returns the geofences in the specified collection, excluding those being deleted.
+func FindGeofencesByCollectionName(ctx context.Context, conn *locationservice.LocationService, collectionName string) ([]*locationservice.ListGeofenceResponseEntry, error) {
+	in := &locationservice.ListGeofencesInput{
+		CollectionName: aws.String(collectionName),
+	}
+
+	var out []*locationservice.ListGeofenceResponseEntry
+
+	err := conn.ListGeofencesPagesWithContext(ctx, in, func(page *locationservice.ListGeofencesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entries {
+			if v == nil {
+				continue
+			}
+
+			if status := aws.StringValue(v.Status); status == geofenceStatusDeleted || status == geofenceStatusDeleting {
+				continue
+			}
+
+			out = append(out, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func expandGeofenceBatchEntries(tfList []interface{}) ([]*locationservice.BatchPutGeofenceRequestEntry, error) {
+	var apiObjects []*locationservice.BatchPutGeofenceRequestEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		geometry, err := expandGeofenceGeometry(tfMap["geometry"].([]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, &locationservice.BatchPutGeofenceRequestEntry{
+			GeofenceId: aws.String(tfMap["geofence_id"].(string)),
+			Geometry:   geometry,
+		})
+	}
+
+	return apiObjects, nil
+}
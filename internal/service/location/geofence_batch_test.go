@@ -0,0 +1,221 @@
+package location_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLocationGeofenceBatch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceBatchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceBatchConfig_count(rName, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceBatchCount(ctx, resourceName, 12),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_name", "aws_location_geofence_collection.test", "collection_name"),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", "12"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGeofenceBatchConfig_count(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceBatchCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofenceBatch_polygon(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence_batch.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceBatchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The configured polygon is not normalized, which must not cause a diff after apply.
+				Config: testAccGeofenceBatchConfig_polygon(rName, "123.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceBatchCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id":        rName + "-polygon",
+						"geometry.0.polygon": "[[[-123.1,49.2],[-123,49.2],[-123,49.3],[-123.1,49.3],[-123.1,49.2]]]",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGeofenceBatchConfig_polygon(rName, "122.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceBatchCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "geofence.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "geofence.*", map[string]string{
+						"geofence_id":        rName + "-polygon",
+						"geometry.0.polygon": "[[[-123.1,49.2],[-122,49.2],[-122,49.3],[-123.1,49.3],[-123.1,49.2]]]",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGeofenceBatchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_geofence_batch" {
+				continue
+			}
+
+			geofences, err := tflocation.FindGeofencesByCollectionName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(geofences) > 0 {
+				return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameGeofenceBatch, rs.Primary.ID, fmt.Errorf("%d geofences remain", len(geofences)))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGeofenceBatchCount(ctx context.Context, name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofenceBatch, name, fmt.Errorf("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+
+		geofences, err := tflocation.FindGeofencesByCollectionName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofenceBatch, rs.Primary.ID, err)
+		}
+
+		if got := len(geofences); got != want {
+			var ids []string
+			for _, v := range geofences {
+				ids = append(ids, aws.StringValue(v.GeofenceId))
+			}
+
+			return fmt.Errorf("expected %d geofences in collection %s, got %d: %v", want, rs.Primary.ID, got, ids)
+		}
+
+		return nil
+	}
+}
+
+func testAccGeofenceBatchConfig_count(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence_batch" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  dynamic "geofence" {
+    for_each = range(%[2]d)
+
+    content {
+      geofence_id = "%[1]s-${geofence.value}"
+
+      geometry {
+        circle {
+          center = [-123.1174 + geofence.value / 100, 49.2847]
+          radius = 100
+        }
+      }
+    }
+  }
+}
+`, rName, n)
+}
+
+func testAccGeofenceBatchConfig_polygon(rName, longitude string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence_batch" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+
+  geofence {
+    geofence_id = "%[1]s-circle"
+
+    geometry {
+      circle {
+        center = [-123.1174, 49.2847]
+        radius = 100
+      }
+    }
+  }
+
+  geofence {
+    geofence_id = "%[1]s-polygon"
+
+    geometry {
+      polygon = <<EOF
+[
+  [
+    [-123.1, 49.2],
+    [-%[2]s, 49.2],
+    [-%[2]s, 49.3],
+    [-123.1, 49.3],
+    [-123.1, 49.2]
+  ]
+]
+EOF
+    }
+  }
+}
+`, rName, longitude)
+}
@@ -0,0 +1,372 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestGeofenceParseID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName           string
+		Input              string
+		ExpectedCollection string
+		ExpectedGeofence   string
+		Error              bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Error:    true,
+		},
+		{
+			TestName: "no pipe",
+			Input:    "collectionNameGeofenceID",
+			Error:    true,
+		},
+		{
+			TestName: "empty part",
+			Input:    "collectionName|",
+			Error:    true,
+		},
+		{
+			TestName:           "valid",
+			Input:              "collectionName|geofenceID",
+			ExpectedCollection: "collectionName",
+			ExpectedGeofence:   "geofenceID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			gotCollection, gotGeofence, err := tflocation.GeofenceParseID(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s, %s) and no error, expected error", gotCollection, gotGeofence)
+			}
+
+			if gotCollection != testCase.ExpectedCollection || gotGeofence != testCase.ExpectedGeofence {
+				t.Errorf("got (%s, %s), expected (%s, %s)", gotCollection, gotGeofence, testCase.ExpectedCollection, testCase.ExpectedGeofence)
+			}
+		})
+	}
+}
+
+func TestAccLocationGeofence_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceConfig_polygon(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "collection_name", "aws_location_geofence_collection.test", "collection_name"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "geofence_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "geometry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.polygon", "[[[-5.716,-15.966],[-5.7,-15.966],[-5.7,-15.95],[-5.716,-15.966]]]"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofence_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceConfig_polygon(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflocation.ResourceGeofence(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofence_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_location_geofence.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGeofenceConfig_polygon(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.#", "0"),
+				),
+			},
+			{
+				Config: testAccGeofenceConfig_circle(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGeofenceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.0.center.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.0.center.0", "-123.1174"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.0.center.1", "49.2847"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.circle.0.radius", "200"),
+					resource.TestCheckResourceAttr(resourceName, "geometry.0.polygon", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofence_invalidPolygon(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGeofenceConfig_polygonOpenRing(rName),
+				ExpectError: regexp.MustCompile(`linear ring 0 is not closed`),
+			},
+		},
+	})
+}
+
+func TestAccLocationGeofence_invalidGeometry(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, locationservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGeofenceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGeofenceConfig_circleCenter(rName, -123.1174, 95),
+				ExpectError: regexp.MustCompile(`is not a valid \[longitude, latitude\] pair`),
+			},
+			{
+				Config:      testAccGeofenceConfig_circleAndPolygon(rName),
+				ExpectError: regexp.MustCompile(`exactly one of circle or polygon must be specified`),
+			},
+		},
+	})
+}
+
+func testAccCheckGeofenceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_location_geofence" {
+				continue
+			}
+
+			collectionName, geofenceID, err := tflocation.GeofenceParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflocation.FindGeofenceByTwoPartKey(ctx, conn, collectionName, geofenceID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Location, create.ErrActionCheckingDestroyed, tflocation.ResNameGeofence, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGeofenceExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofence, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofence, name, errors.New("not set"))
+		}
+
+		collectionName, geofenceID, err := tflocation.GeofenceParseID(rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofence, name, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LocationConn()
+
+		_, err = tflocation.FindGeofenceByTwoPartKey(ctx, conn, collectionName, geofenceID)
+
+		if err != nil {
+			return create.Error(names.Location, create.ErrActionCheckingExistence, tflocation.ResNameGeofence, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccGeofenceConfig_polygon(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+  geofence_id     = %[1]q
+
+  geometry {
+    polygon = jsonencode([[
+      [-5.716, -15.966],
+      [-5.7, -15.966],
+      [-5.7, -15.95],
+      [-5.716, -15.966],
+    ]])
+  }
+}
+`, rName)
+}
+
+func testAccGeofenceConfig_polygonOpenRing(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+  geofence_id     = %[1]q
+
+  geometry {
+    polygon = "[[[-5.716,-15.966],[-5.7,-15.966],[-5.7,-15.95],[-5.71,-15.95]]]"
+  }
+}
+`, rName)
+}
+
+func testAccGeofenceConfig_circle(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+  geofence_id     = %[1]q
+
+  geometry {
+    circle {
+      center = [-123.1174, 49.2847]
+      radius = 200
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGeofenceConfig_circleCenter(rName string, longitude, latitude float64) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+  geofence_id     = %[1]q
+
+  geometry {
+    circle {
+      center = [%[2]g, %[3]g]
+      radius = 200
+    }
+  }
+}
+`, rName, longitude, latitude)
+}
+
+func testAccGeofenceConfig_circleAndPolygon(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_location_geofence_collection" "test" {
+  collection_name = %[1]q
+}
+
+resource "aws_location_geofence" "test" {
+  collection_name = aws_location_geofence_collection.test.collection_name
+  geofence_id     = %[1]q
+
+  geometry {
+    circle {
+      center = [-123.1174, 49.2847]
+      radius = 200
+    }
+
+    polygon = jsonencode([[
+      [-5.716, -15.966],
+      [-5.7, -15.966],
+      [-5.7, -15.95],
+      [-5.716, -15.966],
+    ]])
+  }
+}
+`, rName)
+}
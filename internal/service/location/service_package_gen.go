@@ -54,6 +54,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceGeofence,
+			TypeName: "aws_location_geofence",
+		},
+		{
+			Factory:  ResourceGeofenceBatch,
+			TypeName: "aws_location_geofence_batch",
+		},
 		{
 			Factory:  ResourceGeofenceCollection,
 			TypeName: "aws_location_geofence_collection",
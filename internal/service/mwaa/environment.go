@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -58,6 +62,11 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_update_on_new_object_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -253,7 +262,10 @@ func ResourceEnvironment() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceEnvironmentS3ObjectVersionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -332,6 +344,10 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if err := checkEnvironmentS3Objects(ctx, meta.(*conns.AWSClient).S3Conn(), d); err != nil {
+		return diag.Errorf("creating MWAA Environment (%s): %s", name, err)
+	}
+
 	log.Printf("[INFO] Creating MWAA Environment: %s", input)
 	/*
 		Execution roles created just before the MWAA Environment may result in ValidationExceptions
@@ -497,6 +513,12 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
 		}
 
+		if d.HasChanges("plugins_s3_object_version", "plugins_s3_path", "requirements_s3_object_version", "requirements_s3_path", "source_bucket_arn") {
+			if err := checkEnvironmentS3Objects(ctx, meta.(*conns.AWSClient).S3Conn(), d); err != nil {
+				return diag.Errorf("updating MWAA Environment (%s): %s", d.Id(), err)
+			}
+		}
+
 		log.Printf("[INFO] Updating MWAA Environment: %s", input)
 		_, err := conn.UpdateEnvironmentWithContext(ctx, input)
 
@@ -543,6 +565,117 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// resourceEnvironmentS3ObjectVersionCustomizeDiff plans an environment update when
+// auto_update_on_new_object_version is enabled and a newer version of an unpinned
+// plugins or requirements S3 object is available.
+func resourceEnvironmentS3ObjectVersionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("auto_update_on_new_object_version").(bool) || !d.NewValueKnown("source_bucket_arn") {
+		return nil
+	}
+
+	bucket, err := environmentSourceBucketName(d.Get("source_bucket_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	for _, v := range environmentS3Objects {
+		// A new path is validated by checkEnvironmentS3Objects during apply.
+		if !d.GetRawConfig().GetAttr(v.versionKey).IsNull() || !d.NewValueKnown(v.pathKey) || d.HasChanges(v.pathKey, "source_bucket_arn") {
+			continue
+		}
+
+		key := d.Get(v.pathKey).(string)
+
+		if key == "" {
+			continue
+		}
+
+		output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		// A missing object is reported by checkEnvironmentS3Objects during apply.
+		if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s/%s): %w", bucket, key, err)
+		}
+
+		if version := aws.StringValue(output.VersionId); version != "" && version != d.Get(v.versionKey).(string) {
+			if err := d.SetNew(v.versionKey, version); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+var environmentS3Objects = []struct {
+	pathKey    string
+	versionKey string
+}{
+	{"plugins_s3_path", "plugins_s3_object_version"},
+	{"requirements_s3_path", "requirements_s3_object_version"},
+}
+
+// checkEnvironmentS3Objects verifies that the referenced plugins and requirements
+// S3 objects exist, as an environment update that references a missing object
+// only fails after a lengthy update.
+func checkEnvironmentS3Objects(ctx context.Context, conn *s3.S3, d *schema.ResourceData) error {
+	bucket, err := environmentSourceBucketName(d.Get("source_bucket_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range environmentS3Objects {
+		key := d.Get(v.pathKey).(string)
+
+		if key == "" {
+			continue
+		}
+
+		input := &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		// Read always sets the version in use, which does not apply to a changed path unless the version is also changed or configured.
+		if version := d.Get(v.versionKey).(string); version != "" && (d.HasChange(v.versionKey) || !d.GetRawConfig().GetAttr(v.versionKey).IsNull()) {
+			input.VersionId = aws.String(version)
+		}
+
+		_, err := conn.HeadObjectWithContext(ctx, input)
+
+		if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
+			return fmt.Errorf("%s (%s) version (%s) not found in S3 Bucket (%s)", v.pathKey, key, aws.StringValue(input.VersionId), bucket)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s/%s): %w", bucket, key, err)
+		}
+	}
+
+	return nil
+}
+
+func environmentSourceBucketName(bucketARN string) (string, error) {
+	v, err := arn.Parse(bucketARN)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing source_bucket_arn (%s): %w", bucketARN, err)
+	}
+
+	return v.Resource, nil
+}
+
 func environmentModuleLoggingConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -3,9 +3,12 @@ package mwaa_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/s3"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -349,6 +352,86 @@ func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	})
 }
 
+func TestAccMWAAEnvironment_pluginsS3Path(t *testing.T) {
+	ctx := acctest.Context(t)
+	var environment mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+	s3ObjectResourceName := "aws_s3_object.plugins"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_pluginsS3Path(rName, "plugins.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "plugins_s3_path", "plugins.zip"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				// Change the path without configuring a version.
+				Config: testAccEnvironmentConfig_pluginsS3Path(rName, "plugins-updated.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "plugins_s3_path", "plugins-updated.zip"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMWAAEnvironment_autoUpdateOnNewObjectVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var environment mwaa.Environment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mwaa_environment.test"
+	s3ObjectResourceName := "aws_s3_object.plugins"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mwaa.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_autoUpdateOnNewObjectVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttr(resourceName, "auto_update_on_new_object_version", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+			{
+				// Upload a new version of the object outside of Terraform.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+					_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+						Bucket: aws.String(rName),
+						Key:    aws.String("plugins.zip"),
+						Body:   strings.NewReader("test"),
+					})
+
+					if err != nil {
+						t.Fatalf("uploading S3 Object: %s", err)
+					}
+				},
+				Config: testAccEnvironmentConfig_autoUpdateOnNewObjectVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &environment),
+					resource.TestCheckResourceAttrPair(resourceName, "plugins_s3_object_version", s3ObjectResourceName, "version_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentExists(ctx context.Context, n string, v *mwaa.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -800,6 +883,65 @@ resource "aws_s3_object" "requirements" {
 `, rName))
 }
 
+func testAccEnvironmentConfig_autoUpdateOnNewObjectVersion(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  plugins_s3_path                   = aws_s3_object.plugins.key
+  auto_update_on_new_object_version = true
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+
+resource "aws_s3_object" "plugins" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = "plugins.zip"
+  content = "test"
+}
+`, rName))
+}
+
+func testAccEnvironmentConfig_pluginsS3Path(rName, key string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_mwaa_environment" "test" {
+  dag_s3_path        = aws_s3_object.dags.key
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  network_configuration {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = aws_subnet.private[*].id
+  }
+
+  plugins_s3_path = aws_s3_object.plugins.key
+
+  source_bucket_arn = aws_s3_bucket.test.arn
+}
+
+resource "aws_s3_object" "plugins" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.test]
+
+  bucket  = aws_s3_bucket.test.id
+  acl     = "private"
+  key     = %[2]q
+  content = "test"
+}
+`, rName, key))
+}
+
 func testAccEnvironmentConfig_pluginsS3ObjectVersion(rName, content string) string {
 	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_mwaa_environment" "test" {
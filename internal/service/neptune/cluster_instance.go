@@ -124,6 +124,22 @@ func ResourceClusterInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"pending_modified_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"port": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -269,7 +285,13 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("engine_version", db.EngineVersion)
 	d.Set("engine", db.Engine)
 	d.Set("identifier", db.DBInstanceIdentifier)
-	d.Set("instance_class", db.DBInstanceClass)
+	// A deferred (apply_immediately = false) instance class change is reported as pending
+	// until the next maintenance window. Report the pending value so that it isn't re-submitted.
+	if v := db.PendingModifiedValues; v != nil && v.DBInstanceClass != nil {
+		d.Set("instance_class", v.DBInstanceClass)
+	} else {
+		d.Set("instance_class", db.DBInstanceClass)
+	}
 	d.Set("kms_key_arn", db.KmsKeyId)
 	if len(db.DBParameterGroups) > 0 {
 		d.Set("neptune_parameter_group_name", db.DBParameterGroups[0].DBParameterGroupName)
@@ -277,6 +299,9 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	if db.DBSubnetGroup != nil {
 		d.Set("neptune_subnet_group_name", db.DBSubnetGroup.DBSubnetGroupName)
 	}
+	if err := d.Set("pending_modified_values", flattenPendingModifiedValues(db.PendingModifiedValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_modified_values: %s", err)
+	}
 	d.Set("preferred_backup_window", db.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", db.PreferredMaintenanceWindow)
 	d.Set("promotion_tier", db.PromotionTier)
@@ -365,6 +390,12 @@ func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		if _, err := waitClusterInstanceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Neptune Cluster Instance (%s) update: %s", d.Id(), err)
 		}
+
+		if d.Get("apply_immediately").(bool) && d.HasChange("instance_class") {
+			if _, err := waitClusterInstancePendingModificationsApplied(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Neptune Cluster Instance (%s) pending modifications: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -472,6 +503,34 @@ func statusClusterInstance(ctx context.Context, conn *neptune.Neptune, id string
 	}
 }
 
+// clusterInstanceStatusPendingModifications is a pseudo-status reported while an
+// available instance still has an instance class change pending.
+const clusterInstanceStatusPendingModifications = "pending-modifications"
+
+func statusClusterInstancePendingModifiedValues(ctx context.Context, conn *neptune.Neptune, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := aws.StringValue(output.DBInstanceStatus); status != "available" {
+			return output, status, nil
+		}
+
+		if v := output.PendingModifiedValues; v != nil && v.DBInstanceClass != nil {
+			return output, clusterInstanceStatusPendingModifications, nil
+		}
+
+		return output, "available", nil
+	}
+}
+
 func waitClusterInstanceAvailable(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBInstance, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -505,6 +564,31 @@ func waitClusterInstanceAvailable(ctx context.Context, conn *neptune.Neptune, id
 	return nil, err
 }
 
+func waitClusterInstancePendingModificationsApplied(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			clusterInstanceStatusPendingModifications,
+			"maintenance",
+			"modifying",
+			"rebooting",
+			"storage-optimization",
+			"upgrading",
+		},
+		Target:     []string{"available"},
+		Refresh:    statusClusterInstancePendingModifiedValues(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptune.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterInstanceDeleted(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.DBInstance, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -526,3 +610,16 @@ func waitClusterInstanceDeleted(ctx context.Context, conn *neptune.Neptune, id s
 
 	return nil, err
 }
+
+func flattenPendingModifiedValues(apiObject *neptune.PendingModifiedValues) []interface{} {
+	if apiObject == nil || (apiObject.DBInstanceClass == nil && apiObject.EngineVersion == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"engine_version": aws.StringValue(apiObject.EngineVersion),
+		"instance_class": aws.StringValue(apiObject.DBInstanceClass),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -75,6 +75,47 @@ func TestAccNeptuneClusterInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneClusterInstance_instanceClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v neptune.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_cluster_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.t3.medium", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.r5.large", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.r5.large"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "0"),
+				),
+			},
+			{
+				Config: testAccClusterInstanceConfig_instanceClass(rName, "db.t3.medium", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "instance_class", "db.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_modified_values.0.instance_class", "db.t3.medium"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNeptuneClusterInstance_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v neptune.DBInstance
@@ -399,6 +440,18 @@ resource "aws_neptune_cluster_instance" "cluster_instances" {
 `, rName))
 }
 
+func testAccClusterInstanceConfig_instanceClass(rName, instanceClass string, applyImmediately bool) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), fmt.Sprintf(`
+resource "aws_neptune_cluster_instance" "test" {
+  identifier                   = %[1]q
+  cluster_identifier           = aws_neptune_cluster.test.id
+  instance_class               = %[2]q
+  neptune_parameter_group_name = aws_neptune_parameter_group.test.name
+  apply_immediately            = %[3]t
+}
+`, rName, instanceClass, applyImmediately))
+}
+
 func testAccClusterInstanceConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), `
 resource "aws_neptune_cluster_instance" "test" {
@@ -2,12 +2,16 @@ package networkfirewall
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
 	"log"
 	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -43,6 +47,10 @@ func ResourceRuleGroup() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"consumed_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -425,8 +433,37 @@ func ResourceRuleGroup() *schema.Resource {
 				},
 			},
 			"rules": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rules_source_s3"},
+			},
+			"rules_source_hash": {
 				Type:     schema.TypeString,
-				Optional: true,
+				Computed: true,
+			},
+			"rules_source_s3": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rules", "rule_group"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
@@ -447,6 +484,7 @@ func ResourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			resourceRuleGroupCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
@@ -480,6 +518,18 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.Rules = aws.String(v.(string))
 	}
 
+	var rulesSourceHash string
+	if v, ok := d.GetOk("rules_source_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		rules, hash, err := getRuleGroupRulesFromS3(ctx, meta.(*conns.AWSClient).S3Conn(), v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return diag.Errorf("creating NetworkFirewall Rule Group (%s): %s", name, err)
+		}
+
+		input.Rules = aws.String(rules)
+		rulesSourceHash = hash
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -491,6 +541,7 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.SetId(aws.StringValue(output.RuleGroupResponse.RuleGroupArn))
+	d.Set("rules_source_hash", rulesSourceHash)
 
 	return resourceRuleGroupRead(ctx, d, meta)
 }
@@ -519,6 +570,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	response := output.RuleGroupResponse
 	d.Set("arn", response.RuleGroupArn)
 	d.Set("capacity", response.Capacity)
+	d.Set("consumed_capacity", response.ConsumedCapacity)
 	d.Set("description", response.Description)
 	d.Set("encryption_configuration", flattenEncryptionConfiguration(response.EncryptionConfiguration))
 	d.Set("name", response.RuleGroupName)
@@ -545,7 +597,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
-	if d.HasChanges("description", "encryption_configuration", "rule_group", "rules", "type") {
+	if d.HasChanges("description", "encryption_configuration", "rule_group", "rules", "rules_source_hash", "rules_source_s3", "type") {
 		input := &networkfirewall.UpdateRuleGroupInput{
 			RuleGroupArn: aws.String(d.Id()),
 			Type:         aws.String(d.Get("type").(string)),
@@ -564,8 +616,20 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		// else, request returns "InvalidRequestException: Exactly one of Rules or RuleGroup must be set";
 		// Here, "rules" takes precedence as "rule_group" is Computed from "rules" when configured
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
-		if d.HasChange("rules") {
-			input.Rules = aws.String(d.Get("rules").(string))
+		// Rules sourced from S3 are fetched again whenever the object reference or its content changes.
+		// "rules" isn't read back, so it only changes to empty when another rules source replaces it.
+		var rulesSourceHash string
+		if v := d.Get("rules").(string); d.HasChange("rules") && v != "" {
+			input.Rules = aws.String(v)
+		} else if v, ok := d.GetOk("rules_source_s3"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && d.HasChanges("rules_source_hash", "rules_source_s3") {
+			rules, hash, err := getRuleGroupRulesFromS3(ctx, meta.(*conns.AWSClient).S3Conn(), v.([]interface{})[0].(map[string]interface{}))
+
+			if err != nil {
+				return diag.Errorf("updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
+			}
+
+			input.Rules = aws.String(rules)
+			rulesSourceHash = hash
 		} else if d.HasChange("rule_group") {
 			if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.RuleGroup = expandRuleGroup(v.([]interface{})[0].(map[string]interface{}))
@@ -588,6 +652,12 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		if err != nil {
 			return diag.Errorf("updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
 		}
+
+		if rulesSourceHash != "" {
+			d.Set("rules_source_hash", rulesSourceHash)
+		} else if _, ok := d.GetOk("rules_source_s3"); !ok {
+			d.Set("rules_source_hash", "")
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -629,6 +699,82 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceRuleGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChanges("rule_group", "rules", "rules_source_s3") {
+		if err := d.SetNewComputed("consumed_capacity"); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("rules_source_s3") {
+		return d.SetNewComputed("rules_source_hash")
+	}
+
+	v, ok := d.GetOk("rules_source_s3")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		if d.Get("rules_source_hash").(string) != "" {
+			return d.SetNew("rules_source_hash", "")
+		}
+
+		return nil
+	}
+
+	if d.HasChange("rules_source_s3") {
+		return d.SetNewComputed("rules_source_hash")
+	}
+
+	// Detect changes made to the S3 object outside of Terraform.
+	_, hash, err := getRuleGroupRulesFromS3(ctx, meta.(*conns.AWSClient).S3Conn(), v.([]interface{})[0].(map[string]interface{}))
+
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("rules_source_hash").(string) {
+		if err := d.SetNew("rules_source_hash", hash); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("consumed_capacity")
+	}
+
+	return nil
+}
+
+// getRuleGroupRulesFromS3 returns the Suricata rules stored in the referenced S3 object and the SHA-256 hash of its content.
+func getRuleGroupRulesFromS3(ctx context.Context, conn *s3.S3, tfMap map[string]interface{}) (string, string, error) {
+	bucket, key := tfMap["bucket"].(string), tfMap["key"].(string)
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		input.VersionId = aws.String(v)
+	}
+
+	output, err := conn.GetObjectWithContext(ctx, input)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading rules from S3 object (%s/%s): %w", bucket, key, err)
+	}
+
+	defer output.Body.Close()
+
+	body, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading rules from S3 object (%s/%s): %w", bucket, key, err)
+	}
+
+	return string(body), fmt.Sprintf("%x", sha256.Sum256(body)), nil
+}
+
 func FindRuleGroupByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeRuleGroupOutput, error) {
 	input := &networkfirewall.DescribeRuleGroupInput{
 		RuleGroupArn: aws.String(arn),
@@ -2,6 +2,7 @@ package networkfirewall_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"testing"
 
@@ -263,6 +264,7 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "network-firewall", fmt.Sprintf("stateful-rulegroup/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "consumed_capacity", "1"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "type", networkfirewall.RuleGroupTypeStateful),
 					resource.TestCheckResourceAttr(resourceName, "rules", rules),
@@ -283,6 +285,75 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_rulesSourceS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup1, ruleGroup2, ruleGroup3, ruleGroup4 networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules1 := `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`
+	rules2 := `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)
+alert http any any -> any any (http_response_line; content:"404 Not Found"; sid:2;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkfirewall.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_rulesSourceS3(rName, rules1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup1),
+					resource.TestCheckResourceAttr(resourceName, "consumed_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules1),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_hash", fmt.Sprintf("%x", sha256.Sum256([]byte(rules1)))),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "rules_source_s3.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_s3.0.key", "rules.suricata"),
+					resource.TestCheckResourceAttrPair(resourceName, "rules_source_s3.0.version_id", "aws_s3_object.test", "version_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules_source_hash", "rules_source_s3"},
+			},
+			{
+				Config: testAccRuleGroupConfig_rulesSourceS3(rName, rules2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup2),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup1, &ruleGroup2),
+					resource.TestCheckResourceAttr(resourceName, "consumed_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules2),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_hash", fmt.Sprintf("%x", sha256.Sum256([]byte(rules2)))),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_sourceString(rName, rules1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup3),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup2, &ruleGroup3),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules1),
+					resource.TestCheckResourceAttr(resourceName, "rules", rules1),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_hash", ""),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_s3.#", "0"),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_rulesSourceS3(rName, rules2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup4),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup3, &ruleGroup4),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules2),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_hash", fmt.Sprintf("%x", sha256.Sum256([]byte(rules2)))),
+					resource.TestCheckResourceAttr(resourceName, "rules_source_s3.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_statefulRuleOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1577,6 +1648,42 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_rulesSourceS3(rName, rules string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "rules.suricata"
+  content = %[2]q
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rules_source_s3 {
+    bucket     = aws_s3_object.test.bucket
+    key        = aws_s3_object.test.key
+    version_id = aws_s3_object.test.version_id
+  }
+}
+`, rName, rules)
+}
+
 func testAccRuleGroupConfig_sourceString(rName, rules string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
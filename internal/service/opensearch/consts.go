@@ -7,3 +7,8 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	// ZIP-PLUGIN is not yet defined by the AWS SDK for Go.
+	packageTypeZipPlugin = "ZIP-PLUGIN"
+)
@@ -0,0 +1,6 @@
+package opensearch
+
+// Exports for use in tests only.
+var (
+	SAMLOptionsChangeIsDynamic = samlOptionsChangeIsDynamic
+)
@@ -0,0 +1,252 @@
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	packageAssociationIDPartCount = 2
+)
+
+// @SDKResource("aws_opensearch_package_association")
+func ResourcePackageAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageAssociationCreate,
+		ReadWithoutTimeout:   resourcePackageAssociationRead,
+		UpdateWithoutTimeout: resourcePackageAssociationUpdate,
+		DeleteWithoutTimeout: resourcePackageAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"prefer_latest"},
+			},
+			"prefer_latest": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"package_version"},
+			},
+			"reference_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourcePackageAssociationCustomizeDiff,
+	}
+}
+
+func resourcePackageAssociationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Plan an update to the package's latest version whenever a newer one is available.
+	if d.Id() == "" || !d.Get("prefer_latest").(bool) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	pkg, err := FindPackageByID(ctx, conn, d.Get("package_id").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading OpenSearch Package (%s): %w", d.Get("package_id").(string), err)
+	}
+
+	if v := aws.StringValue(pkg.AvailablePackageVersion); v != "" && v != d.Get("package_version").(string) {
+		return d.SetNew("package_version", v)
+	}
+
+	return nil
+}
+
+func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+	id, err := flex.FlattenResourceId([]string{domainName, packageID}, packageAssociationIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association: %s", err)
+	}
+
+	pkg, err := FindPackageByID(ctx, conn, packageID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package (%s): %s", packageID, err)
+	}
+
+	if aws.StringValue(pkg.PackageType) == packageTypeZipPlugin {
+		domain, err := FindDomainByName(ctx, conn, domainName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading OpenSearch Domain (%s): %s", domainName, err)
+		}
+
+		if v := aws.StringValue(domain.EngineVersion); !strings.HasPrefix(v, "OpenSearch_") {
+			return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s packages require an OpenSearch engine version, domain engine version is %s", id, packageTypeZipPlugin, v)
+		}
+	}
+
+	if err := associatePackage(ctx, conn, d, pkg, domainName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageAssociationIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	domainName, packageID := parts[0], parts[1]
+	association, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", association.DomainName)
+	d.Set("package_id", association.PackageID)
+	d.Set("package_version", association.PackageVersion)
+	d.Set("reference_path", association.ReferencePath)
+
+	return diags
+}
+
+func resourcePackageAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	if d.HasChange("package_version") {
+		domainName := d.Get("domain_name").(string)
+		packageID := d.Get("package_id").(string)
+
+		pkg, err := FindPackageByID(ctx, conn, packageID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package (%s): %s", packageID, err)
+		}
+
+		if err := associatePackage(ctx, conn, d, pkg, domainName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn()
+
+	domainName := d.Get("domain_name").(string)
+	packageID := d.Get("package_id").(string)
+
+	log.Printf("[DEBUG] Deleting OpenSearch Package Association: %s", d.Id())
+	_, err := conn.DissociatePackageWithContext(ctx, &opensearchservice.DissociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPackageAssociationDeleted(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// associatePackage associates the package's latest available version with the domain.
+// Associating an already associated package updates the domain to that version.
+func associatePackage(ctx context.Context, conn *opensearchservice.OpenSearchService, d *schema.ResourceData, pkg *opensearchservice.PackageDetails, domainName string, timeout time.Duration) error {
+	packageID := aws.StringValue(pkg.PackageID)
+
+	// AssociatePackage has no version parameter, so only the latest available version can be associated.
+	if v, ok := d.GetOk("package_version"); ok {
+		if available := aws.StringValue(pkg.AvailablePackageVersion); v.(string) != available {
+			return fmt.Errorf("package version %s is not the latest available version (%s) of OpenSearch Package (%s)", v.(string), available, packageID)
+		}
+	}
+
+	input := &opensearchservice.AssociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	}
+
+	log.Printf("[DEBUG] Associating OpenSearch Package: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.AssociatePackageWithContext(ctx, input)
+	}, opensearchservice.ErrCodeConflictException)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitPackageAssociationActive(ctx, conn, domainName, packageID, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
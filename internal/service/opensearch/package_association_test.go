@@ -0,0 +1,222 @@
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var association opensearchservice.DomainPackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+	packageResourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, "synonyms.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", packageResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "prefer_latest", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_path"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"prefer_latest"},
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var association opensearchservice.DomainPackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, "synonyms.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfopensearch.ResourcePackageAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_preferLatest(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 opensearchservice.DomainPackageDetails
+	rName := testAccRandomDomainName()
+	resourceName := "aws_opensearch_package_association.test"
+	packageResourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_preferLatest(rName, "synonyms.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "prefer_latest", "true"),
+				),
+			},
+			// The package's new version is only known once the package has been updated.
+			{
+				Config:             testAccPackageAssociationConfig_preferLatest(rName, "synonyms-updated.txt"),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPackageAssociationConfig_preferLatest(rName, "synonyms-updated.txt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(ctx, resourceName, &v2),
+					testAccCheckPackageAssociationNewVersion(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "package_version", packageResourceName, "available_package_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageAssociationExists(ctx context.Context, n string, v *opensearchservice.DomainPackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		output, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_name"], rs.Primary.Attributes["package_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearch_package_association" {
+				continue
+			}
+
+			_, err := tfopensearch.FindPackageAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_name"], rs.Primary.Attributes["package_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("OpenSearch Package Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPackageAssociationNewVersion(before, after *opensearchservice.DomainPackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.PackageVersion, *after.PackageVersion; before == after {
+			return fmt.Errorf("OpenSearch Package Association version not updated: %s", after)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageAssociationConfig_base(rName, key string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_basic(rName, key), fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name    = %[1]q
+  engine_version = "OpenSearch_2.5"
+
+  cluster_config {
+    instance_type = "t3.small.search" # supported in both aws and aws-us-gov
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+`, rName))
+}
+
+func testAccPackageAssociationConfig_basic(rName, key string) string {
+	return acctest.ConfigCompose(testAccPackageAssociationConfig_base(rName, key), `
+resource "aws_opensearch_package_association" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  package_id  = aws_opensearch_package.test.id
+}
+`)
+}
+
+func testAccPackageAssociationConfig_preferLatest(rName, key string) string {
+	return acctest.ConfigCompose(testAccPackageAssociationConfig_base(rName, key), `
+resource "aws_opensearch_package_association" "test" {
+  domain_name   = aws_opensearch_domain.test.domain_name
+  package_id    = aws_opensearch_package.test.id
+  prefer_latest = true
+}
+`)
+}
@@ -0,0 +1,299 @@
+package opensearchserverless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	securityPolicyTypeEncryption = "encryption"
+	securityPolicyTypeNetwork    = "network"
+)
+
+func securityPolicyType_Values() []string {
+	return []string{
+		securityPolicyTypeEncryption,
+		securityPolicyTypeNetwork,
+	}
+}
+
+const (
+	securityPolicyResourceTypeCollection = "collection"
+	securityPolicyResourceTypeDashboard  = "dashboard"
+)
+
+func securityPolicyResourceType_Values() []string {
+	return []string{
+		securityPolicyResourceTypeCollection,
+		securityPolicyResourceTypeDashboard,
+	}
+}
+
+// Collection names start with a lowercase letter and contain only lowercase letters, digits and hyphens.
+// A trailing "*" matches every collection whose name starts with the given prefix.
+var securityPolicyResourcePatternRegexp = regexp.MustCompile(`^collection/(\*|[a-z][a-z0-9-]*\*?)$`)
+
+type securityPolicyRule struct {
+	ResourceType string   `json:"ResourceType"`
+	Resource     []string `json:"Resource"`
+}
+
+type encryptionPolicyDocument struct {
+	Rules       []*securityPolicyRule `json:"Rules"`
+	AWSOwnedKey *bool                 `json:"AWSOwnedKey,omitempty"`
+	KmsARN      string                `json:"KmsARN,omitempty"`
+}
+
+type networkPolicyStatement struct {
+	Description     string                `json:"Description,omitempty"`
+	Rules           []*securityPolicyRule `json:"Rules"`
+	AllowFromPublic *bool                 `json:"AllowFromPublic,omitempty"`
+	SourceVPCEs     []string              `json:"SourceVPCEs,omitempty"`
+}
+
+// @SDKDataSource("aws_opensearchserverless_security_policy_document")
+func DataSourceSecurityPolicyDocument() *schema.Resource {
+	ruleSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(securityPolicyResourcePatternRegexp, `must be of the form "collection/<name>" or "collection/<prefix>*"`),
+				},
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityPolicyResourceType_Values(), false),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecurityPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"aws_owned_key": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"kms_arn", "network_statement"},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"aws_owned_key", "network_statement"},
+			},
+			"network_statement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_from_public": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     ruleSchema,
+						},
+						"source_vpces": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rule": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"network_statement"},
+				Elem:          ruleSchema,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityPolicyType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceSecurityPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var document interface{}
+
+	switch policyType := d.Get("type").(string); policyType {
+	case securityPolicyTypeEncryption:
+		rules := expandSecurityPolicyRules(d.Get("rule").([]interface{}))
+
+		if len(rules) == 0 {
+			return sdkdiag.AppendErrorf(diags, "an encryption policy must contain at least one rule")
+		}
+
+		for _, rule := range rules {
+			if rule.ResourceType != securityPolicyResourceTypeCollection {
+				return sdkdiag.AppendErrorf(diags, "encryption policy rules only support the %q resource type, got %q", securityPolicyResourceTypeCollection, rule.ResourceType)
+			}
+		}
+
+		apiObject := &encryptionPolicyDocument{
+			Rules: rules,
+		}
+
+		if v, ok := d.GetOk("kms_arn"); ok {
+			apiObject.KmsARN = v.(string)
+		} else if d.Get("aws_owned_key").(bool) {
+			apiObject.AWSOwnedKey = aws.Bool(true)
+		} else {
+			return sdkdiag.AppendErrorf(diags, "an encryption policy must either set aws_owned_key to true or specify kms_arn")
+		}
+
+		document = apiObject
+	case securityPolicyTypeNetwork:
+		tfList := d.Get("network_statement").([]interface{})
+
+		if len(tfList) == 0 {
+			return sdkdiag.AppendErrorf(diags, "a network policy must contain at least one network_statement")
+		}
+
+		var apiObjects []*networkPolicyStatement
+
+		for i, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject, err := expandNetworkPolicyStatement(tfMap)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "network_statement %d: %s", i, err)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		document = apiObjects
+	default:
+		return sdkdiag.AppendErrorf(diags, "unsupported security policy type: %s", policyType)
+	}
+
+	output, err := json.Marshal(document)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "marshaling OpenSearch Serverless Security Policy document: %s", err)
+	}
+
+	jsonString := string(output)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandNetworkPolicyStatement(tfMap map[string]interface{}) (*networkPolicyStatement, error) {
+	apiObject := &networkPolicyStatement{
+		Rules: expandSecurityPolicyRules(tfMap["rule"].([]interface{})),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = v
+	}
+
+	allowFromPublic, _ := tfMap["allow_from_public"].(bool)
+
+	var sourceVPCEs []string
+	if v, ok := tfMap["source_vpces"].(*schema.Set); ok && v.Len() > 0 {
+		sourceVPCEs = flex.ExpandStringValueSet(v)
+		sort.Strings(sourceVPCEs)
+	}
+
+	switch {
+	case allowFromPublic && len(sourceVPCEs) > 0:
+		return nil, fmt.Errorf("allow_from_public and source_vpces can't both be set")
+	case allowFromPublic:
+		apiObject.AllowFromPublic = aws.Bool(true)
+	case len(sourceVPCEs) > 0:
+		apiObject.SourceVPCEs = sourceVPCEs
+	default:
+		return nil, fmt.Errorf("either allow_from_public must be true or source_vpces must be specified")
+	}
+
+	return apiObject, nil
+}
+
+// expandSecurityPolicyRules merges rules that share a resource type, so that each
+// resource type appears once in the generated policy with a sorted, de-duplicated list of resources.
+func expandSecurityPolicyRules(tfList []interface{}) []*securityPolicyRule {
+	var resourceTypes []string
+	resources := make(map[string]map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		resourceType := tfMap["resource_type"].(string)
+
+		if _, ok := resources[resourceType]; !ok {
+			resourceTypes = append(resourceTypes, resourceType)
+			resources[resourceType] = make(map[string]struct{})
+		}
+
+		for _, v := range flex.ExpandStringValueSet(tfMap["resource"].(*schema.Set)) {
+			resources[resourceType][v] = struct{}{}
+		}
+	}
+
+	var apiObjects []*securityPolicyRule
+
+	for _, resourceType := range resourceTypes {
+		apiObject := &securityPolicyRule{
+			ResourceType: resourceType,
+		}
+
+		for v := range resources[resourceType] {
+			apiObject.Resource = append(apiObject.Resource, v)
+		}
+
+		sort.Strings(apiObject.Resource)
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,133 @@
+package opensearchserverless_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessSecurityPolicyDocumentDataSource_encryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_opensearchserverless_security_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyDocumentDataSourceConfig_encryption,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `{"Rules":[{"ResourceType":"collection","Resource":["collection/logs*","collection/metrics"]}],"AWSOwnedKey":true}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityPolicyDocumentDataSource_network(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_opensearchserverless_security_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecurityPolicyDocumentDataSourceConfig_network,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", `[{"Description":"Public dashboards","Rules":[{"ResourceType":"dashboard","Resource":["collection/logs"]}],"AllowFromPublic":true},{"Rules":[{"ResourceType":"collection","Resource":["collection/logs"]}],"SourceVPCEs":["vpce-0123456789abcdef0"]}]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchServerlessSecurityPolicyDocumentDataSource_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecurityPolicyDocumentDataSourceConfig_invalidResource,
+				ExpectError: regexp.MustCompile(`must be of the form "collection/<name>"`),
+			},
+			{
+				Config:      testAccSecurityPolicyDocumentDataSourceConfig_missingKey,
+				ExpectError: regexp.MustCompile(`must either set aws_owned_key to true or specify kms_arn`),
+			},
+		},
+	})
+}
+
+const testAccSecurityPolicyDocumentDataSourceConfig_encryption = `
+data "aws_opensearchserverless_security_policy_document" "test" {
+  type          = "encryption"
+  aws_owned_key = true
+
+  rule {
+    resource_type = "collection"
+    resource      = ["collection/metrics"]
+  }
+
+  rule {
+    resource_type = "collection"
+    resource      = ["collection/logs*", "collection/metrics"]
+  }
+}
+`
+
+const testAccSecurityPolicyDocumentDataSourceConfig_network = `
+data "aws_opensearchserverless_security_policy_document" "test" {
+  type = "network"
+
+  network_statement {
+    description       = "Public dashboards"
+    allow_from_public = true
+
+    rule {
+      resource_type = "dashboard"
+      resource      = ["collection/logs"]
+    }
+  }
+
+  network_statement {
+    source_vpces = ["vpce-0123456789abcdef0"]
+
+    rule {
+      resource_type = "collection"
+      resource      = ["collection/logs"]
+    }
+  }
+}
+`
+
+const testAccSecurityPolicyDocumentDataSourceConfig_invalidResource = `
+data "aws_opensearchserverless_security_policy_document" "test" {
+  type          = "encryption"
+  aws_owned_key = true
+
+  rule {
+    resource_type = "collection"
+    resource      = ["logs"]
+  }
+}
+`
+
+const testAccSecurityPolicyDocumentDataSourceConfig_missingKey = `
+data "aws_opensearchserverless_security_policy_document" "test" {
+  type = "encryption"
+
+  rule {
+    resource_type = "collection"
+    resource      = ["collection/logs"]
+  }
+}
+`
@@ -30,6 +30,11 @@ func ResourcePrincipalAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(PrincipalAssociationTimeout),
+			Delete: schema.DefaultTimeout(PrincipalDisassociationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_share_arn": {
 				Type:         schema.TypeString,
@@ -47,6 +52,11 @@ func ResourcePrincipalAssociation() *schema.Resource {
 					verify.ValidARN,
 				),
 			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -77,7 +87,8 @@ func resourcePrincipalAssociationCreate(ctx context.Context, d *schema.ResourceD
 		return append(diags, resourcePrincipalAssociationRead(ctx, d, meta)...)
 	}
 
-	if _, err := WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal); err != nil {
+	// Organization and Organizational Unit principals take a while to propagate to member accounts.
+	if _, err := WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM principal association (%s) to become ready: %s", d.Id(), err)
 	}
 
@@ -93,14 +104,7 @@ func resourcePrincipalAssociationRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading RAM Principal Association, parsing ID (%s): %s", d.Id(), err)
 	}
 
-	var association *ram.ResourceShareAssociation
-
-	if ok, _ := regexp.MatchString(`^\d{12}$`, principal); ok {
-		// AWS Account ID Principals need to be accepted to become ASSOCIATED
-		association, err = FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareArn, principal)
-	} else {
-		association, err = WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareArn, principal)
-	}
+	association, err := FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareArn, principal)
 
 	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException) || tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException)) {
 		log.Printf("[WARN] No RAM resource share principal association with ARN (%s) found, removing from state", d.Id())
@@ -118,12 +122,17 @@ func resourcePrincipalAssociationRead(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
+	if aws.StringValue(association.Status) == ram.ResourceShareAssociationStatusFailed {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) Principal Association (%s): %s", resourceShareArn, principal, principalAssociationFailedError(association))
+	}
+
 	if aws.StringValue(association.Status) != ram.ResourceShareAssociationStatusAssociated && aws.StringValue(association.Status) != ram.ResourceShareAssociationStatusAssociating {
 		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) Principal Association (%s), status not associating or associated: %s", resourceShareArn, principal, aws.StringValue(association.Status))
 	}
 
 	d.Set("resource_share_arn", resourceShareArn)
 	d.Set("principal", principal)
+	d.Set("status", association.Status)
 
 	return diags
 }
@@ -153,7 +162,7 @@ func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "deleting RAM Resource Share Principal Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareArn, principal); err != nil {
+	if _, err := WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareArn, principal, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting RAM Resource Share Principal Association (%s): waiting for completion: %s", d.Id(), err)
 	}
 
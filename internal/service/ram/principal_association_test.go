@@ -32,6 +32,7 @@ func TestAccRAMPrincipalAssociation_basic(t *testing.T) {
 				Config: testAccPrincipalAssociationConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPrincipalAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
 				),
 			},
 			{
@@ -92,7 +93,7 @@ func testAccCheckPrincipalAssociationExists(ctx context.Context, resourceName st
 			// AWS Account ID Principals need to be accepted to become ASSOCIATED
 			association, err = tfram.FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareARN, principal)
 		} else {
-			association, err = tfram.WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareARN, principal)
+			association, err = tfram.WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareARN, principal, tfram.PrincipalAssociationTimeout)
 		}
 
 		if err != nil {
@@ -128,7 +129,7 @@ func testAccCheckPrincipalAssociationDestroy(ctx context.Context) resource.TestC
 				return err
 			}
 
-			association, err := tfram.WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareARN, principal)
+			association, err := tfram.WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareARN, principal, tfram.PrincipalDisassociationTimeout)
 
 			if err != nil {
 				return err
@@ -3,6 +3,7 @@ package ram
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
@@ -75,10 +76,21 @@ func StatusResourceSharePrincipalAssociation(ctx context.Context, conn *ram.RAM,
 		}
 
 		if aws.StringValue(association.Status) == ram.ResourceShareAssociationStatusFailed {
-			extendedErr := fmt.Errorf("association status message: %s", aws.StringValue(association.StatusMessage))
-			return association, aws.StringValue(association.Status), extendedErr
+			return association, aws.StringValue(association.Status), principalAssociationFailedError(association)
 		}
 
 		return association, aws.StringValue(association.Status), nil
 	}
 }
+
+// principalAssociationFailedError returns an error describing why a principal association FAILED.
+// Organization and Organizational Unit principals can only be associated once RAM sharing with AWS Organizations has been enabled.
+func principalAssociationFailedError(association *ram.ResourceShareAssociation) error {
+	message := aws.StringValue(association.StatusMessage)
+
+	if v := strings.ToLower(message); strings.Contains(v, "organization") && (strings.Contains(v, "not enabled") || strings.Contains(v, "enablesharingwithawsorganization")) {
+		return fmt.Errorf("association status message: %s. Enable RAM sharing with AWS Organizations (EnableSharingWithAwsOrganization) from the organization's management account before associating Organization or Organizational Unit principals", message)
+	}
+
+	return fmt.Errorf("association status message: %s", message)
+}
@@ -85,12 +85,12 @@ func WaitResourceShareOwnedBySelfDeleted(ctx context.Context, conn *ram.RAM, arn
 	return nil, err
 }
 
-func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string) (*ram.ResourceShareAssociation, error) {
+func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string, timeout time.Duration) (*ram.ResourceShareAssociation, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ram.ResourceShareAssociationStatusAssociating, PrincipalAssociationStatusNotFound},
 		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
 		Refresh: StatusResourceSharePrincipalAssociation(ctx, conn, resourceShareARN, principal),
-		Timeout: PrincipalAssociationTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -102,12 +102,12 @@ func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, re
 	return nil, err
 }
 
-func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string) (*ram.ResourceShareAssociation, error) {
+func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string, timeout time.Duration) (*ram.ResourceShareAssociation, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusDisassociating},
 		Target:  []string{ram.ResourceShareAssociationStatusDisassociated, PrincipalAssociationStatusNotFound},
 		Refresh: StatusResourceSharePrincipalAssociation(ctx, conn, resourceShareARN, principal),
-		Timeout: PrincipalDisassociationTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -2,13 +2,16 @@ package redshift
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -28,6 +31,10 @@ func ResourceScheduledAction() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			resourceScheduledActionCustomizeDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
@@ -52,9 +59,15 @@ func ResourceScheduledAction() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"next_invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"schedule": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(at|cron)\(.+\)$`), "must be an at(...) or cron(...) expression"),
 			},
 			"start_time": {
 				Type:         schema.TypeString,
@@ -74,8 +87,9 @@ func ResourceScheduledAction() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"cluster_identifier": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validClusterIdentifier,
 									},
 								},
 							},
@@ -97,20 +111,23 @@ func ResourceScheduledAction() *schema.Resource {
 										Default:  false,
 									},
 									"cluster_identifier": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validClusterIdentifier,
 									},
 									"cluster_type": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{clusterTypeMultiNode, clusterTypeSingleNode}, false),
 									},
 									"node_type": {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
 									"number_of_nodes": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
 									},
 								},
 							},
@@ -127,8 +144,9 @@ func ResourceScheduledAction() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"cluster_identifier": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validClusterIdentifier,
 									},
 								},
 							},
@@ -179,13 +197,7 @@ func resourceScheduledActionCreate(ctx context.Context, d *schema.ResourceData,
 		func() (interface{}, error) {
 			return conn.CreateScheduledActionWithContext(ctx, input)
 		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
-				return true, err
-			}
-
-			return false, err
-		},
+		retryScheduledActionIAMRolePropagation,
 	)
 
 	if err != nil {
@@ -226,6 +238,11 @@ func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("iam_role", scheduledAction.IamRole)
 	d.Set("name", scheduledAction.ScheduledActionName)
+	var nextInvocations []string
+	for _, v := range scheduledAction.NextInvocations {
+		nextInvocations = append(nextInvocations, aws.TimeValue(v).Format(time.RFC3339))
+	}
+	d.Set("next_invocations", nextInvocations)
 	d.Set("schedule", scheduledAction.Schedule)
 	if scheduledAction.StartTime != nil {
 		d.Set("start_time", aws.TimeValue(scheduledAction.StartTime).Format(time.RFC3339))
@@ -285,13 +302,18 @@ func resourceScheduledActionUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Updating Redshift Scheduled Action: %s", input)
-	_, err := conn.ModifyScheduledActionWithContext(ctx, input)
+	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.ModifyScheduledActionWithContext(ctx, input)
+		},
+		retryScheduledActionIAMRolePropagation,
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Redshift Scheduled Action (%s): %s", d.Id(), err)
 	}
 
-	return diags
+	return append(diags, resourceScheduledActionRead(ctx, d, meta)...)
 }
 
 func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -314,6 +336,40 @@ func resourceScheduledActionDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// retryScheduledActionIAMRolePropagation retries while a newly created IAM role isn't yet assumable by the Redshift scheduler.
+func retryScheduledActionIAMRolePropagation(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "The IAM role must delegate access to Amazon Redshift scheduler") {
+		return true, err
+	}
+
+	return false, err
+}
+
+func resourceScheduledActionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("target_action") {
+		return nil
+	}
+
+	tfList, ok := diff.Get("target_action.0.resize_cluster").([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	clusterType, _ := tfMap["cluster_type"].(string)
+	numberOfNodes, _ := tfMap["number_of_nodes"].(int)
+
+	switch {
+	case clusterType == clusterTypeSingleNode && numberOfNodes > 1:
+		return fmt.Errorf("target_action.0.resize_cluster: number_of_nodes must be 1 for a %s cluster, got %d", clusterTypeSingleNode, numberOfNodes)
+	case clusterType == clusterTypeMultiNode && numberOfNodes == 1:
+		return fmt.Errorf("target_action.0.resize_cluster: number_of_nodes must be at least 2 for a %s cluster", clusterTypeMultiNode)
+	}
+
+	return nil
+}
+
 func expandScheduledActionType(tfMap map[string]interface{}) *redshift.ScheduledActionType {
 	if tfMap == nil {
 		return nil
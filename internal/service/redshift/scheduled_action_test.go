@@ -3,6 +3,7 @@ package redshift_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ func TestAccRedshiftScheduledAction_basicPauseCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enable", "true"),
 					resource.TestCheckResourceAttr(resourceName, "end_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "next_invocations.#"),
 					resource.TestCheckResourceAttr(resourceName, "schedule", "cron(00 23 * * ? *)"),
 					resource.TestCheckResourceAttr(resourceName, "start_time", ""),
 					resource.TestCheckResourceAttr(resourceName, "target_action.#", "1"),
@@ -263,6 +265,32 @@ func TestAccRedshiftScheduledAction_resizeClusterWithOptions(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftScheduledAction_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduledActionConfig_pauseClusterIdentifier(rName, "cron(00 23 * * ? *)", "Invalid--Identifier"),
+				ExpectError: regexp.MustCompile(`cannot contain two consecutive hyphens`),
+			},
+			{
+				Config:      testAccScheduledActionConfig_pauseClusterIdentifier(rName, "rate(1 day)", "tf-test-identifier"),
+				ExpectError: regexp.MustCompile(`must be an at\(...\) or cron\(...\) expression`),
+			},
+			{
+				Config:      testAccScheduledActionConfig_resizeClusterFullOptions(rName, "cron(00 23 * * ? *)", true, "single-node", "dc2.large", 2),
+				ExpectError: regexp.MustCompile(`number_of_nodes must be 1 for a single-node cluster`),
+			},
+		},
+	})
+}
+
 func TestAccRedshiftScheduledAction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v redshift.ScheduledAction
@@ -408,6 +436,22 @@ resource "aws_redshift_scheduled_action" "test" {
 `, rName, schedule))
 }
 
+func testAccScheduledActionConfig_pauseClusterIdentifier(rName, schedule, clusterIdentifier string) string {
+	return acctest.ConfigCompose(testAccScheduledActionBaseConfig(rName), fmt.Sprintf(`
+resource "aws_redshift_scheduled_action" "test" {
+  name     = %[1]q
+  schedule = %[2]q
+  iam_role = aws_iam_role.test.arn
+
+  target_action {
+    pause_cluster {
+      cluster_identifier = %[3]q
+    }
+  }
+}
+`, rName, schedule, clusterIdentifier))
+}
+
 func testAccScheduledActionConfig_pauseClusterFullOptions(rName, schedule, description string, enable bool, startTime, endTime string) string {
 	return acctest.ConfigCompose(testAccScheduledActionBaseConfig(rName), fmt.Sprintf(`
 resource "aws_redshift_scheduled_action" "test" {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,9 +43,9 @@ func ResourceGroup() *schema.Resource {
 				Computed: true,
 			},
 			"configuration": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				ConflictsWith: []string{"resource_query"},
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"configuration", "resource_query"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"parameters": {
@@ -83,11 +84,11 @@ func ResourceGroup() *schema.Resource {
 				ForceNew: true,
 			},
 			"resource_query": {
-				Type:          schema.TypeList,
-				Optional:      true,
-				MinItems:      1,
-				MaxItems:      1,
-				ConflictsWith: []string{"configuration"},
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"configuration", "resource_query"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"query": {
@@ -109,7 +110,10 @@ func ResourceGroup() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffGroupType,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -197,11 +201,17 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		if err := d.Set("resource_query", []map[string]interface{}{resultQuery}); err != nil {
 			return diag.Errorf("setting resource_query: %s", err)
 		}
+		d.Set("configuration", nil)
 	}
 
 	if isConfigurationGroup {
 		groupCfg, err := findGroupConfigurationByGroupName(ctx, conn, d.Id())
 
+		// A group may have neither a resource query nor a configuration.
+		if tfresource.NotFound(err) {
+			groupCfg, err = &resourcegroups.GroupConfiguration{}, nil
+		}
+
 		if err != nil {
 			return diag.Errorf("reading Resource Groups Group (%s) configuration: %s", d.Id(), err)
 		}
@@ -209,6 +219,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		if err := d.Set("configuration", flattenResourceGroupConfigurationItems(groupCfg.Configuration)); err != nil {
 			return diag.Errorf("setting configuration: %s", err)
 		}
+		d.Set("resource_query", nil)
 	}
 
 	tags, err := ListTags(ctx, conn, arn)
@@ -234,11 +245,6 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ResourceGroupsConn()
 
-	// Conversion between a resource-query and configuration group is not possible and vice-versa
-	if d.HasChange("configuration") && d.HasChange("resource_query") {
-		return diag.Errorf("conversion between resource-query and configuration group types is not possible")
-	}
-
 	if d.HasChange("description") {
 		input := &resourcegroups.UpdateGroupInput{
 			Description: aws.String(d.Get("description").(string)),
@@ -312,6 +318,20 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// customizeDiffGroupType prevents converting an existing group between the resource-query and configuration group types.
+func customizeDiffGroupType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("configuration") || !diff.HasChange("resource_query") {
+		return nil
+	}
+
+	o, n := diff.GetChange("resource_query")
+	if len(o.([]interface{})) == len(n.([]interface{})) {
+		return nil
+	}
+
+	return errors.New("conversion between resource-query and configuration group types is not possible")
+}
+
 func FindGroupByName(ctx context.Context, conn *resourcegroups.ResourceGroups, name string) (*resourcegroups.Group, error) {
 	input := &resourcegroups.GetGroupInput{
 		GroupName: aws.String(name),
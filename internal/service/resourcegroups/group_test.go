@@ -186,6 +186,24 @@ func TestAccResourceGroupsGroup_Configuration(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_noResourceQueryOrConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, resourcegroups.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGroupConfig_noResourceQueryOrConfiguration(rName),
+				ExpectError: regexp.MustCompile(`one of .configuration,resource_query. must be specified`),
+			},
+		},
+	})
+}
+
 func TestAccResourceGroupsGroup_configurationParametersOptional(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v resourcegroups.Group
@@ -304,6 +322,14 @@ JSON
 `, rName, desc, query)
 }
 
+func testAccGroupConfig_noResourceQueryOrConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
 func testAccGroupConfig_tags1(rName, desc, query, tag1Key, tag1Value string) string {
 	return fmt.Sprintf(`
 resource "aws_resourcegroups_group" "test" {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +23,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 // @SDKResource("aws_route53_health_check")
@@ -188,10 +190,63 @@ func ResourceHealthCheck() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceHealthCheckCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+var (
+	healthCheckEndpointTypes = []string{
+		route53.HealthCheckTypeHttp,
+		route53.HealthCheckTypeHttps,
+		route53.HealthCheckTypeHttpStrMatch,
+		route53.HealthCheckTypeHttpsStrMatch,
+		route53.HealthCheckTypeTcp,
+	}
+
+	// healthCheckArgumentTypes maps arguments to the health check types that accept them.
+	healthCheckArgumentTypes = map[string][]string{
+		"child_health_threshold":          {route53.HealthCheckTypeCalculated},
+		"child_healthchecks":              {route53.HealthCheckTypeCalculated},
+		"cloudwatch_alarm_name":           {route53.HealthCheckTypeCloudwatchMetric},
+		"cloudwatch_alarm_region":         {route53.HealthCheckTypeCloudwatchMetric},
+		"fqdn":                            healthCheckEndpointTypes,
+		"insufficient_data_health_status": {route53.HealthCheckTypeCloudwatchMetric},
+		"ip_address":                      healthCheckEndpointTypes,
+		"port":                            healthCheckEndpointTypes,
+		"regions":                         healthCheckEndpointTypes,
+		"resource_path":                   healthCheckEndpointTypes,
+		"routing_control_arn":             {route53.HealthCheckTypeRecoveryControl},
+		"search_string":                   {route53.HealthCheckTypeHttpStrMatch, route53.HealthCheckTypeHttpsStrMatch},
+	}
+
+	// healthCheckRequiredArguments maps health check types to the arguments they require.
+	healthCheckRequiredArguments = map[string][]string{
+		route53.HealthCheckTypeCloudwatchMetric: {"cloudwatch_alarm_name", "cloudwatch_alarm_region"},
+		route53.HealthCheckTypeRecoveryControl:  {"routing_control_arn"},
+	}
+)
+
+func resourceHealthCheckCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	healthCheckType := strings.ToUpper(diff.Get("type").(string))
+
+	for argument, types := range healthCheckArgumentTypes {
+		if _, ok := diff.GetOk(argument); ok && !slices.Contains(types, healthCheckType) {
+			return fmt.Errorf("%s can't be set when type is %s", argument, healthCheckType)
+		}
+	}
+
+	for _, argument := range healthCheckRequiredArguments[healthCheckType] {
+		if _, ok := diff.GetOk(argument); !ok && diff.NewValueKnown(argument) {
+			return fmt.Errorf("%s is required when type is %s", argument, healthCheckType)
+		}
+	}
+
+	return nil
+}
+
 func resourceHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn()
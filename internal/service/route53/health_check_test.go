@@ -162,6 +162,26 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_invalidArgumentForType(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_calculatedInsufficientDataHealthStatus,
+				ExpectError: regexp.MustCompile(`insufficient_data_health_status can't be set when type is CALCULATED`),
+			},
+			{
+				Config:      testAccHealthCheckConfig_recoveryControlNoRoutingControlARN,
+				ExpectError: regexp.MustCompile(`routing_control_arn is required when type is RECOVERY_CONTROL`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withHealthCheckRegions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
@@ -550,6 +570,20 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+const testAccHealthCheckConfig_calculatedInsufficientDataHealthStatus = `
+resource "aws_route53_health_check" "test" {
+  type                            = "CALCULATED"
+  child_health_threshold          = 1
+  insufficient_data_health_status = "Healthy"
+}
+`
+
+const testAccHealthCheckConfig_recoveryControlNoRoutingControlARN = `
+resource "aws_route53_health_check" "test" {
+  type = "RECOVERY_CONTROL"
+}
+`
+
 func testAccHealthCheckConfig_regions(regions ...string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -0,0 +1,276 @@
+package route53domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_route53domains_delegation_signer_record")
+func ResourceDelegationSignerRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDelegationSignerRecordCreate,
+		ReadWithoutTimeout:   resourceDelegationSignerRecordRead,
+		DeleteWithoutTimeout: resourceDelegationSignerRecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest_type": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dnssec_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_tag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"signing_attributes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"flags": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntInSlice([]int{256, 257}),
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDelegationSignerRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsClient()
+
+	domainName := d.Get("domain_name").(string)
+	signingAttributes := expandDNSSECSigningAttributes(d.Get("signing_attributes").([]interface{})[0].(map[string]interface{}))
+	input := &route53domains.AssociateDelegationSignerToDomainInput{
+		DomainName:        aws.String(domainName),
+		SigningAttributes: signingAttributes,
+	}
+
+	log.Printf("[DEBUG] Associating Route 53 Domains Domain delegation signer: %#v", input)
+	output, err := conn.AssociateDelegationSignerToDomain(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("associating Route 53 Domains Domain (%s) delegation signer: %s", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Route 53 Domains Domain (%s) delegation signer associate: %s", domainName, err)
+	}
+
+	// The association operation doesn't return the key's ID.
+	dnssecKey, err := findDNSSECKeyByPublicKey(ctx, conn, domainName, aws.ToString(signingAttributes.PublicKey))
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Domains Domain (%s) delegation signer: %s", domainName, err)
+	}
+
+	d.SetId(DelegationSignerRecordCreateResourceID(domainName, aws.ToString(dnssecKey.Id)))
+
+	return resourceDelegationSignerRecordRead(ctx, d, meta)
+}
+
+func resourceDelegationSignerRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsClient()
+
+	domainName, dnssecKeyID, err := DelegationSignerRecordParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnssecKey, err := FindDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Domains Domain delegation signer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Route 53 Domains Domain delegation signer (%s): %s", d.Id(), err)
+	}
+
+	d.Set("digest", dnssecKey.Digest)
+	d.Set("digest_type", dnssecKey.DigestType)
+	d.Set("dnssec_key_id", dnssecKey.Id)
+	d.Set("domain_name", domainName)
+	d.Set("key_tag", dnssecKey.KeyTag)
+	if err := d.Set("signing_attributes", []interface{}{flattenDNSSECKeySigningAttributes(dnssecKey)}); err != nil {
+		return diag.Errorf("setting signing_attributes: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDelegationSignerRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsClient()
+
+	domainName, dnssecKeyID, err := DelegationSignerRecordParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Domains Domain delegation signer: %s", d.Id())
+	output, err := conn.DisassociateDelegationSignerFromDomain(ctx, &route53domains.DisassociateDelegationSignerFromDomainInput{
+		DomainName: aws.String(domainName),
+		Id:         aws.String(dnssecKeyID),
+	})
+
+	if err != nil {
+		if _, err := FindDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID); tfresource.NotFound(err) {
+			return nil
+		}
+
+		return diag.Errorf("disassociating Route 53 Domains Domain delegation signer (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Route 53 Domains Domain delegation signer (%s) disassociate: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const delegationSignerRecordIDSeparator = ","
+
+func DelegationSignerRecordCreateResourceID(domainName, dnssecKeyID string) string {
+	parts := []string{domainName, dnssecKeyID}
+	id := strings.Join(parts, delegationSignerRecordIDSeparator)
+
+	return id
+}
+
+func DelegationSignerRecordParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, delegationSignerRecordIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DomainName%[2]sDNSSECKeyID", id, delegationSignerRecordIDSeparator)
+}
+
+func FindDNSSECKeyByTwoPartKey(ctx context.Context, conn *route53domains.Client, domainName, dnssecKeyID string) (*types.DnssecKey, error) {
+	return findDNSSECKey(ctx, conn, domainName, func(v types.DnssecKey) bool {
+		return aws.ToString(v.Id) == dnssecKeyID
+	})
+}
+
+func findDNSSECKeyByPublicKey(ctx context.Context, conn *route53domains.Client, domainName, publicKey string) (*types.DnssecKey, error) {
+	return findDNSSECKey(ctx, conn, domainName, func(v types.DnssecKey) bool {
+		return aws.ToString(v.PublicKey) == publicKey
+	})
+}
+
+func findDNSSECKey(ctx context.Context, conn *route53domains.Client, domainName string, filter func(types.DnssecKey) bool) (*types.DnssecKey, error) {
+	output, err := findDomainDetailByName(ctx, conn, domainName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.DnssecKeys {
+		if filter(v) {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func expandDNSSECSigningAttributes(tfMap map[string]interface{}) *types.DnssecSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DnssecSigningAttributes{}
+
+	if v, ok := tfMap["algorithm"].(int); ok {
+		apiObject.Algorithm = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["flags"].(int); ok {
+		apiObject.Flags = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["public_key"].(string); ok && v != "" {
+		apiObject.PublicKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDNSSECKeySigningAttributes(apiObject *types.DnssecKey) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Algorithm; v != nil {
+		tfMap["algorithm"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Flags; v != nil {
+		tfMap["flags"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.PublicKey; v != nil {
+		tfMap["public_key"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,171 @@
+package route53domains_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDelegationSignerRecord_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "ROUTE53DOMAINS_DOMAIN_NAME"
+	domainName := os.Getenv(key)
+	if domainName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53domains_delegation_signer_record.test"
+	kskResourceName := "aws_route53_key_signing_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53DomainsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDelegationSignerRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegationSignerRecordConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDelegationSignerRecordExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "digest"),
+					resource.TestCheckResourceAttrSet(resourceName, "dnssec_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_tag", kskResourceName, "key_tag"),
+					resource.TestCheckResourceAttr(resourceName, "signing_attributes.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "signing_attributes.0.algorithm", kskResourceName, "signing_algorithm_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "signing_attributes.0.flags", kskResourceName, "flag"),
+					resource.TestCheckResourceAttrPair(resourceName, "signing_attributes.0.public_key", kskResourceName, "public_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDelegationSignerRecordDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53domains_delegation_signer_record" {
+				continue
+			}
+
+			domainName, dnssecKeyID, err := tfroute53domains.DelegationSignerRecordParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfroute53domains.FindDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route 53 Domains Domain delegation signer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDelegationSignerRecordExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Domains Domain delegation signer ID is set")
+		}
+
+		domainName, dnssecKeyID, err := tfroute53domains.DelegationSignerRecordParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsClient()
+
+		_, err = tfroute53domains.FindDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID)
+
+		return err
+	}
+}
+
+func testAccDelegationSignerRecordConfig_basic(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  customer_master_key_spec = "ECC_NIST_P256"
+  deletion_window_in_days  = 7
+  key_usage                = "SIGN_VERIFY"
+  policy = jsonencode({
+    Statement = [
+      {
+        Action = [
+          "kms:DescribeKey",
+          "kms:GetPublicKey",
+          "kms:Sign",
+        ],
+        Effect = "Allow"
+        Principal = {
+          Service = "api-service.dnssec.route53.aws.internal"
+        }
+        Sid = "Allow Route 53 DNSSEC Service"
+      },
+      {
+        Action = "kms:*"
+        Effect = "Allow"
+        Principal = {
+          AWS = "*"
+        }
+        Resource = "*"
+        Sid      = "Enable IAM User Permissions"
+      },
+    ]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_key_signing_key" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  name                       = %[1]q
+}
+
+resource "aws_route53domains_delegation_signer_record" "test" {
+  domain_name = %[2]q
+
+  signing_attributes {
+    algorithm  = aws_route53_key_signing_key.test.signing_algorithm_type
+    flags      = aws_route53_key_signing_key.test.flag
+    public_key = aws_route53_key_signing_key.test.public_key
+  }
+}
+`, rName, domainName)
+}
@@ -103,25 +103,54 @@ func resourceBucketVersioningCreate(ctx context.Context, d *schema.ResourceData,
 	// supported within the aws_s3_bucket resource of the 3.x version of the provider.
 	// Thus, we essentially bring existing bucket versioning into adoption.
 	if aws.StringValue(versioningConfiguration.Status) != BucketVersioningStatusDisabled {
-		input := &s3.PutBucketVersioningInput{
-			Bucket:                  aws.String(bucket),
-			VersioningConfiguration: versioningConfiguration,
+		// Buckets whose versioning already matches the configuration are adopted as-is,
+		// avoiding a PutBucketVersioning call that could require an MFA token.
+		input := &s3.GetBucketVersioningInput{
+			Bucket: aws.String(bucket),
 		}
 
 		if expectedBucketOwner != "" {
 			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 		}
 
-		if v, ok := d.GetOk("mfa"); ok {
-			input.MFA = aws.String(v.(string))
-		}
+		output, err := conn.GetBucketVersioningWithContext(ctx, input)
+
+		if err == nil && bucketVersioningConfigurationMatches(output, versioningConfiguration) {
+			log.Printf("[DEBUG] Adopting existing S3 bucket versioning: %s", bucket)
+		} else {
+			input := &s3.PutBucketVersioningInput{
+				Bucket:                  aws.String(bucket),
+				VersioningConfiguration: versioningConfiguration,
+			}
+
+			if expectedBucketOwner != "" {
+				input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+			}
+
+			// The MFA token is only needed when the MFA delete status changes or is already enabled.
+			var mfaDelete string
 
-		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
-			return conn.PutBucketVersioningWithContext(ctx, input)
-		}, s3.ErrCodeNoSuchBucket)
+			if err == nil {
+				if mfaDelete = aws.StringValue(output.MFADelete); mfaDelete == "" {
+					mfaDelete = s3.MFADeleteStatusDisabled
+				}
+
+				if mfaDelete == aws.StringValue(versioningConfiguration.MFADelete) {
+					versioningConfiguration.MFADelete = nil
+				}
+			}
 
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error creating S3 bucket versioning for %s: %w", bucket, err))
+			if v, ok := d.GetOk("mfa"); ok && (versioningConfiguration.MFADelete != nil || mfaDelete == s3.MFADeleteStatusEnabled) {
+				input.MFA = aws.String(v.(string))
+			}
+
+			_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+				return conn.PutBucketVersioningWithContext(ctx, input)
+			}, s3.ErrCodeNoSuchBucket)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error creating S3 bucket versioning for %s: %w", bucket, err))
+			}
 		}
 	} else {
 		log.Printf("[DEBUG] Creating S3 bucket versioning for unversioned bucket: %s", bucket)
@@ -171,6 +200,11 @@ func resourceBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// A new MFA token on its own doesn't change the bucket's versioning configuration.
+	if !d.HasChange("versioning_configuration") {
+		return resourceBucketVersioningRead(ctx, d, meta)
+	}
+
 	input := &s3.PutBucketVersioningInput{
 		Bucket:                  aws.String(bucket),
 		VersioningConfiguration: expandBucketVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
@@ -180,7 +214,15 @@ func resourceBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData,
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	if v, ok := d.GetOk("mfa"); ok {
+	// The MFA token is only needed when the MFA delete status changes or is already enabled.
+	o, _ := d.GetChange("versioning_configuration.0.mfa_delete")
+	mfaDeleteChanged := d.HasChange("versioning_configuration.0.mfa_delete")
+
+	if !mfaDeleteChanged {
+		input.VersioningConfiguration.MFADelete = nil
+	}
+
+	if v, ok := d.GetOk("mfa"); ok && (mfaDeleteChanged || o.(string) == s3.MFADeleteStatusEnabled) {
 		input.MFA = aws.String(v.(string))
 	}
 
@@ -237,6 +279,32 @@ func resourceBucketVersioningDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// bucketVersioningConfigurationMatches returns whether a bucket's current versioning matches the desired configuration.
+// An unset MFA delete status in the desired configuration matches any current status.
+func bucketVersioningConfigurationMatches(output *s3.GetBucketVersioningOutput, config *s3.VersioningConfiguration) bool {
+	if output == nil || config == nil {
+		return false
+	}
+
+	if aws.StringValue(output.Status) != aws.StringValue(config.Status) {
+		return false
+	}
+
+	if v := aws.StringValue(config.MFADelete); v != "" {
+		current := aws.StringValue(output.MFADelete)
+
+		if current == "" {
+			current = s3.MFADeleteStatusDisabled
+		}
+
+		if v != current {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandBucketVersioningConfiguration(l []interface{}) *s3.VersioningConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
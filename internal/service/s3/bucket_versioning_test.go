@@ -160,6 +160,21 @@ func TestAccS3BucketVersioning_MFADelete(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// A token that isn't needed (MFA delete status unchanged) must not be sent.
+			{
+				Config: testAccBucketVersioningConfig_mfaDeleteWithMFA(rName, s3.MFADeleteDisabled, "arn:aws:iam::123456789012:mfa/test 123456"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketVersioningExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.mfa_delete", s3.MFADeleteDisabled),
+				),
+			},
+			{
+				Config: testAccBucketVersioningConfig_mfaDeleteWithMFA(rName, s3.MFADeleteDisabled, "arn:aws:iam::123456789012:mfa/test 654321"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketVersioningExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.mfa_delete", s3.MFADeleteDisabled),
+				),
+			},
 		},
 	})
 }
@@ -584,6 +599,29 @@ resource "aws_s3_bucket_versioning" "test" {
 `, rName, mfaDelete)
 }
 
+func testAccBucketVersioningConfig_mfaDeleteWithMFA(rName, mfaDelete, mfa string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  mfa    = %[3]q
+
+  versioning_configuration {
+    mfa_delete = %[2]q
+    status     = "Enabled"
+  }
+}
+`, rName, mfaDelete, mfa)
+}
+
 func testAccBucketVersioningConfig_migrateEnabled(rName, status string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -2,6 +2,7 @@ package securityhub
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
@@ -55,6 +56,59 @@ func FindInsight(ctx context.Context, conn *securityhub.SecurityHub, arn string)
 	return output.Insights[0], nil
 }
 
+func FindStandardsControlAssociationByTwoPartKey(ctx context.Context, conn *securityhub.SecurityHub, standardsARN, securityControlID string) (*securityhub.StandardsControlAssociationDetail, error) {
+	input := &securityhub.BatchGetStandardsControlAssociationsInput{
+		StandardsControlAssociationIds: []*securityhub.StandardsControlAssociationId{{
+			SecurityControlId: aws.String(securityControlID),
+			StandardsArn:      aws.String(standardsARN),
+		}},
+	}
+
+	output, err := conn.BatchGetStandardsControlAssociationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.UnprocessedAssociations {
+		if v == nil {
+			continue
+		}
+
+		err := fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorReason))
+
+		if aws.StringValue(v.ErrorCode) == securityhub.UnprocessedErrorCodeNotFound {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		return nil, err
+	}
+
+	if len(output.StandardsControlAssociationDetails) == 0 || output.StandardsControlAssociationDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.StandardsControlAssociationDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.StandardsControlAssociationDetails[0], nil
+}
+
 func FindStandardsControlByStandardsSubscriptionARNAndStandardsControlARN(ctx context.Context, conn *securityhub.SecurityHub, standardsSubscriptionARN, standardsControlARN string) (*securityhub.StandardsControl, error) {
 	input := &securityhub.DescribeStandardsControlsInput{
 		StandardsSubscriptionArn: aws.String(standardsSubscriptionARN),
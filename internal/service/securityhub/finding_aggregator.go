@@ -101,10 +101,7 @@ func resourceFindingAggregatorRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.Set("linking_mode", aggregator.RegionLinkingMode)
-
-	if len(aggregator.Regions) > 0 {
-		d.Set("specified_regions", flex.FlattenStringList(aggregator.Regions))
-	}
+	d.Set("specified_regions", flex.FlattenStringList(aggregator.Regions))
 
 	return diags
 }
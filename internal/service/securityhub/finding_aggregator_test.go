@@ -54,6 +54,14 @@ func testAccFindingAggregator_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "specified_regions.#", "2"),
 				),
 			},
+			{
+				Config: testAccFindingAggregatorConfig_allRegions(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFindingAggregatorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linking_mode", "ALL_REGIONS"),
+					resource.TestCheckNoResourceAttr(resourceName, "specified_regions"),
+				),
+			},
 		},
 	})
 }
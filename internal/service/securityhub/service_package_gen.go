@@ -65,6 +65,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceStandardsControl,
 			TypeName: "aws_securityhub_standards_control",
 		},
+		{
+			Factory:  ResourceStandardsControlAssociation,
+			TypeName: "aws_securityhub_standards_control_association",
+		},
 		{
 			Factory:  ResourceStandardsSubscription,
 			TypeName: "aws_securityhub_standards_subscription",
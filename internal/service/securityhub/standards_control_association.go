@@ -0,0 +1,186 @@
+package securityhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	standardsControlAssociationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_securityhub_standards_control_association")
+func ResourceStandardsControlAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStandardsControlAssociationPut,
+		ReadWithoutTimeout:   resourceStandardsControlAssociationRead,
+		UpdateWithoutTimeout: resourceStandardsControlAssociationPut,
+		DeleteWithoutTimeout: resourceStandardsControlAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"association_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(securityhub.AssociationStatus_Values(), false),
+			},
+			"related_requirements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_control_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_control_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"standards_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"standards_control_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"standards_control_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standards_control_title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceStandardsControlAssociationCustomizeDiff,
+	}
+}
+
+func resourceStandardsControlAssociationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
+
+	standardsARN := d.Get("standards_arn").(string)
+	securityControlID := d.Get("security_control_id").(string)
+	id, err := flex.FlattenResourceId([]string{standardsARN, securityControlID}, standardsControlAssociationResourceIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	update := &securityhub.StandardsControlAssociationUpdate{
+		AssociationStatus: aws.String(d.Get("association_status").(string)),
+		SecurityControlId: aws.String(securityControlID),
+		StandardsArn:      aws.String(standardsARN),
+	}
+
+	if v, ok := d.GetOk("updated_reason"); ok {
+		update.UpdatedReason = aws.String(v.(string))
+	}
+
+	input := &securityhub.BatchUpdateStandardsControlAssociationsInput{
+		StandardsControlAssociationUpdates: []*securityhub.StandardsControlAssociationUpdate{update},
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Standards Control Association: %s", input)
+	output, err := conn.BatchUpdateStandardsControlAssociationsWithContext(ctx, input)
+
+	if err == nil && output != nil && len(output.UnprocessedAssociationUpdates) > 0 && output.UnprocessedAssociationUpdates[0] != nil {
+		v := output.UnprocessedAssociationUpdates[0]
+		err = fmt.Errorf("%s: %s", aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorReason))
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Standards Control Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceStandardsControlAssociationRead(ctx, d, meta)...)
+}
+
+func resourceStandardsControlAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), standardsControlAssociationResourceIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	standardsARN, securityControlID := parts[0], parts[1]
+	association, err := FindStandardsControlAssociationByTwoPartKey(ctx, conn, standardsARN, securityControlID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Standards Control Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Standards Control Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("association_status", association.AssociationStatus)
+	d.Set("related_requirements", aws.StringValueSlice(association.RelatedRequirements))
+	d.Set("security_control_arn", association.SecurityControlArn)
+	d.Set("security_control_id", association.SecurityControlId)
+	d.Set("standards_arn", association.StandardsArn)
+	d.Set("standards_control_arns", aws.StringValueSlice(association.StandardsControlArns))
+	d.Set("standards_control_description", association.StandardsControlDescription)
+	d.Set("standards_control_title", association.StandardsControlTitle)
+	if association.UpdatedAt != nil {
+		d.Set("updated_at", aws.TimeValue(association.UpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("updated_at", nil)
+	}
+	d.Set("updated_reason", association.UpdatedReason)
+
+	return diags
+}
+
+func resourceStandardsControlAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Cannot delete Security Hub Standards Control Association. Terraform will remove this resource from the state.")
+	return nil
+}
+
+func resourceStandardsControlAssociationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("association_status").(string) == securityhub.AssociationStatusDisabled && d.GetRawConfig().GetAttr("updated_reason").IsNull() {
+		return fmt.Errorf("updated_reason is required when association_status is %s", securityhub.AssociationStatusDisabled)
+	}
+
+	return nil
+}
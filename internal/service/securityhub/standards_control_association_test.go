@@ -0,0 +1,107 @@
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+)
+
+func testAccStandardsControlAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association securityhub.StandardsControlAssociationDetail
+	resourceName := "aws_securityhub_standards_control_association.test"
+	standardsSubscriptionResourceName := "aws_securityhub_standards_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, securityhub.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil, //lintignore:AT001
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStandardsControlAssociationConfig_basic("ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStandardsControlAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "association_status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "security_control_id", "IAM.1"),
+					resource.TestCheckResourceAttrSet(resourceName, "security_control_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "standards_arn", standardsSubscriptionResourceName, "standards_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "standards_control_title"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStandardsControlAssociationConfig_disabled("Handled by a compensating control"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStandardsControlAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, "association_status", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "updated_reason", "Handled by a compensating control"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStandardsControlAssociationExists(ctx context.Context, n string, v *securityhub.StandardsControlAssociationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Security Hub Standards Control Association ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
+
+		output, err := tfsecurityhub.FindStandardsControlAssociationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccStandardsControlAssociationConfig_basic(associationStatus string) string {
+	return acctest.ConfigCompose(testAccStandardsSubscriptionConfig_basic, fmt.Sprintf(`
+resource "aws_securityhub_standards_control_association" "test" {
+  standards_arn       = aws_securityhub_standards_subscription.test.standards_arn
+  security_control_id = "IAM.1"
+  association_status  = %[1]q
+}
+`, associationStatus))
+}
+
+func testAccStandardsControlAssociationConfig_disabled(updatedReason string) string {
+	return acctest.ConfigCompose(testAccStandardsSubscriptionConfig_basic, fmt.Sprintf(`
+resource "aws_securityhub_standards_control_association" "test" {
+  standards_arn       = aws_securityhub_standards_subscription.test.standards_arn
+  security_control_id = "IAM.1"
+  association_status  = "DISABLED"
+  updated_reason      = %[1]q
+}
+`, updatedReason))
+}
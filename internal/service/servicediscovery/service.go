@@ -115,9 +115,14 @@ func ResourceService() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"failure_threshold": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							ForceNew: true,
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Computed:   true,
+							Deprecated: "failure_threshold is deprecated. The value is ignored by AWS Cloud Map and is always set to 1.",
+							// The value can only be set on create and the API rejects it on update.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return d.Id() != ""
+							},
 						},
 					},
 				},
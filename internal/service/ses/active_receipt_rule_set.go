@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -24,11 +25,20 @@ func ResourceActiveReceiptRuleSet() *schema.Resource {
 		ReadWithoutTimeout:   resourceActiveReceiptRuleSetRead,
 		DeleteWithoutTimeout: resourceActiveReceiptRuleSetDelete,
 
+		CustomizeDiff: customdiff.ComputedIf("arn", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("rule_set_name")
+		}),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deactivate_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"rule_set_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -42,6 +52,8 @@ func resourceActiveReceiptRuleSetUpdate(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SESConn()
 
+	// Activating a rule set atomically deactivates the previously active one,
+	// so changing rule_set_name is a single in-place call.
 	ruleSetName := d.Get("rule_set_name").(string)
 
 	createOpts := &ses.SetActiveReceiptRuleSetInput{
@@ -75,22 +87,29 @@ func resourceActiveReceiptRuleSetRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if response.Metadata == nil {
-		log.Print("[WARN] No active Receipt Rule Set found")
+		log.Printf("[WARN] SES Active Receipt Rule Set (%s) deactivated, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
 
-	d.Set("rule_set_name", response.Metadata.Name)
+	// A different active rule set is reported as drift in rule_set_name.
+	ruleSetName := aws.StringValue(response.Metadata.Name)
+	d.Set("rule_set_name", ruleSetName)
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
 		Region:    meta.(*conns.AWSClient).Region,
 		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("receipt-rule-set/%s", d.Id()),
+		Resource:  fmt.Sprintf("receipt-rule-set/%s", ruleSetName),
 	}.String()
 	d.Set("arn", arn)
 
+	// Default deactivate_on_destroy for state written before the argument was added.
+	if rawState := d.GetRawState(); !rawState.IsNull() && rawState.GetAttr("deactivate_on_destroy").IsNull() {
+		d.Set("deactivate_on_destroy", true)
+	}
+
 	return diags
 }
 
@@ -98,11 +117,26 @@ func resourceActiveReceiptRuleSetDelete(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SESConn()
 
+	if !d.Get("deactivate_on_destroy").(bool) {
+		log.Printf("[DEBUG] Retaining SES Active Receipt Rule Set (%s)", d.Id())
+		return diags
+	}
+
+	response, err := conn.DescribeActiveReceiptRuleSetWithContext(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SES Active Receipt Rule Set: %s", err)
+	}
+
+	// Don't deactivate a rule set that was activated outside of this resource.
+	if response.Metadata == nil || aws.StringValue(response.Metadata.Name) != d.Id() {
+		return diags
+	}
+
 	deleteOpts := &ses.SetActiveReceiptRuleSetInput{
 		RuleSetName: nil,
 	}
 
-	_, err := conn.SetActiveReceiptRuleSetWithContext(ctx, deleteOpts)
+	_, err = conn.SetActiveReceiptRuleSetWithContext(ctx, deleteOpts)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting active SES rule set: %s", err)
 	}
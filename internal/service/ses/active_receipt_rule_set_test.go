@@ -22,8 +22,10 @@ func TestAccSESActiveReceiptRuleSet_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Resource": {
-			"basic":      testAccActiveReceiptRuleSet_basic,
-			"disappears": testAccActiveReceiptRuleSet_disappears,
+			"basic":               testAccActiveReceiptRuleSet_basic,
+			"deactivateOnDestroy": testAccActiveReceiptRuleSet_deactivateOnDestroy,
+			"disappears":          testAccActiveReceiptRuleSet_disappears,
+			"update":              testAccActiveReceiptRuleSet_update,
 		},
 		"DataSource": {
 			"basic":           testAccActiveReceiptRuleSetDataSource_basic,
@@ -60,6 +62,76 @@ func testAccActiveReceiptRuleSet_basic(t *testing.T) {
 	})
 }
 
+func testAccActiveReceiptRuleSet_deactivateOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_active_receipt_rule_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActiveReceiptRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActiveReceiptRuleSetConfig_deactivateOnDestroy(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveReceiptRuleSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deactivate_on_destroy", "false"),
+				),
+			},
+			{
+				// Removing the resource leaves the rule set active. Deactivate it afterwards so that the rule set can be deleted.
+				Config: testAccActiveReceiptRuleSetConfig_ruleSetOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveReceiptRuleSetIsActive(ctx, rName),
+					testAccActiveReceiptRuleSetDeactivate(ctx),
+				),
+			},
+		},
+	})
+}
+
+func testAccActiveReceiptRuleSet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ses_active_receipt_rule_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			testAccPreCheckReceiptRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ses.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActiveReceiptRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActiveReceiptRuleSetConfig_twoRuleSets(rName1, rName2, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveReceiptRuleSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName1),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("receipt-rule-set/%s", rName1)),
+				),
+			},
+			{
+				Config: testAccActiveReceiptRuleSetConfig_twoRuleSets(rName1, rName2, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckActiveReceiptRuleSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName2),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("receipt-rule-set/%s", rName2)),
+				),
+			},
+		},
+	})
+}
+
 func testAccActiveReceiptRuleSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -136,6 +208,49 @@ func testAccCheckActiveReceiptRuleSetExists(ctx context.Context, n string) resou
 	}
 }
 
+func testAccCheckActiveReceiptRuleSetIsActive(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
+
+		response, err := conn.DescribeActiveReceiptRuleSetWithContext(ctx, &ses.DescribeActiveReceiptRuleSetInput{})
+		if err != nil {
+			return err
+		}
+
+		if response.Metadata == nil || aws.StringValue(response.Metadata.Name) != name {
+			return fmt.Errorf("SES Receipt Rule Set (%s) is not active", name)
+		}
+
+		return nil
+	}
+}
+
+func testAccActiveReceiptRuleSetDeactivate(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
+
+		_, err := conn.SetActiveReceiptRuleSetWithContext(ctx, &ses.SetActiveReceiptRuleSetInput{})
+
+		return err
+	}
+}
+
+func testAccActiveReceiptRuleSetConfig_twoRuleSets(rName1, rName2, active string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test1" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_receipt_rule_set" "test2" {
+  rule_set_name = %[2]q
+}
+
+resource "aws_ses_active_receipt_rule_set" "test" {
+  rule_set_name = aws_ses_receipt_rule_set.%[3]s.rule_set_name
+}
+`, rName1, rName2, active)
+}
+
 func testAccActiveReceiptRuleSetConfig_basic(name string) string {
 	return fmt.Sprintf(`
 resource "aws_ses_receipt_rule_set" "test" {
@@ -147,3 +262,24 @@ resource "aws_ses_active_receipt_rule_set" "test" {
 }
 `, name)
 }
+
+func testAccActiveReceiptRuleSetConfig_deactivateOnDestroy(name string, deactivateOnDestroy bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+
+resource "aws_ses_active_receipt_rule_set" "test" {
+  rule_set_name         = aws_ses_receipt_rule_set.test.rule_set_name
+  deactivate_on_destroy = %[2]t
+}
+`, name, deactivateOnDestroy)
+}
+
+func testAccActiveReceiptRuleSetConfig_ruleSetOnly(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_receipt_rule_set" "test" {
+  rule_set_name = %[1]q
+}
+`, name)
+}
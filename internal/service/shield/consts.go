@@ -0,0 +1,9 @@
+package shield
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,173 @@
+package shield
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_shield_drt_access_log_bucket_association")
+func ResourceDRTAccessLogBucketAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDRTAccessLogBucketAssociationCreate,
+		ReadWithoutTimeout:   resourceDRTAccessLogBucketAssociationRead,
+		UpdateWithoutTimeout: resourceDRTAccessLogBucketAssociationUpdate,
+		DeleteWithoutTimeout: resourceDRTAccessLogBucketAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_buckets": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+			},
+			"role_arn_association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDRTAccessLogBucketAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	if err := associateDRTLogBuckets(ctx, conn, flex.ExpandStringValueSet(d.Get("log_buckets").(*schema.Set))); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "associating Shield DRT log buckets: %s", err)
+	}
+
+	// Keep any buckets that were associated so that they are tracked in state.
+	output, err := FindDRTAccess(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Shield DRT access: %s", err)
+	}
+
+	if len(output.LogBucketList) == 0 {
+		return diags
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	return append(diags, resourceDRTAccessLogBucketAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDRTAccessLogBucketAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	output, err := FindDRTAccess(ctx, conn)
+
+	if err == nil && len(output.LogBucketList) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield DRT Log Bucket Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Shield DRT Log Bucket Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("log_buckets", aws.StringValueSlice(output.LogBucketList))
+
+	return diags
+}
+
+func resourceDRTAccessLogBucketAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	if d.HasChange("log_buckets") {
+		o, n := d.GetChange("log_buckets")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := disassociateDRTLogBuckets(ctx, conn, flex.ExpandStringValueSet(os.Difference(ns))); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "disassociating Shield DRT log buckets: %s", err)
+		}
+
+		if err := associateDRTLogBuckets(ctx, conn, flex.ExpandStringValueSet(ns.Difference(os))); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "associating Shield DRT log buckets: %s", err)
+		}
+	}
+
+	return append(diags, resourceDRTAccessLogBucketAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDRTAccessLogBucketAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	log.Printf("[DEBUG] Deleting Shield DRT Log Bucket Association: %s", d.Id())
+	if err := disassociateDRTLogBuckets(ctx, conn, flex.ExpandStringValueSet(d.Get("log_buckets").(*schema.Set))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Shield DRT Log Bucket Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// associateDRTLogBuckets associates each bucket in turn, returning the errors for any buckets that couldn't be associated.
+func associateDRTLogBuckets(ctx context.Context, conn *shield.Shield, logBuckets []string) error {
+	var errs *multierror.Error
+
+	for _, logBucket := range logBuckets {
+		input := &shield.AssociateDRTLogBucketInput{
+			LogBucket: aws.String(logBucket),
+		}
+
+		if _, err := conn.AssociateDRTLogBucketWithContext(ctx, input); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("associating log bucket (%s): %w", logBucket, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// disassociateDRTLogBuckets disassociates each bucket in turn, returning the errors for any buckets that couldn't be disassociated.
+// Buckets are treated as already disassociated if Shield Advanced has been unsubscribed or the DRT role has been removed.
+func disassociateDRTLogBuckets(ctx context.Context, conn *shield.Shield, logBuckets []string) error {
+	var errs *multierror.Error
+
+	for _, logBucket := range logBuckets {
+		input := &shield.DisassociateDRTLogBucketInput{
+			LogBucket: aws.String(logBucket),
+		}
+
+		_, err := conn.DisassociateDRTLogBucketWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException, shield.ErrCodeNoAssociatedRoleException) {
+			continue
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("disassociating log bucket (%s): %w", logBucket, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
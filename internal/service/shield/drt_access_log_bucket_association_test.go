@@ -0,0 +1,127 @@
+package shield_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldDRTAccessLogBucketAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_shield_drt_access_log_bucket_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, shield.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, shield.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDRTAccessLogBucketAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDRTAccessLogBucketAssociationConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDRTAccessLogBucketAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_buckets.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_arn_association_id"},
+			},
+			{
+				Config: testAccDRTAccessLogBucketAssociationConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDRTAccessLogBucketAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_buckets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDRTAccessLogBucketAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_shield_drt_access_log_bucket_association" {
+				continue
+			}
+
+			output, err := tfshield.FindDRTAccess(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.LogBucketList) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Shield DRT Log Bucket Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDRTAccessLogBucketAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield DRT Log Bucket Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+		output, err := tfshield.FindDRTAccess(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LogBucketList) == 0 {
+			return fmt.Errorf("Shield DRT Log Bucket Association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDRTAccessLogBucketAssociationConfig_basic(rName string, bucketCount int) string {
+	return acctest.ConfigCompose(testAccDRTAccessRoleARNAssociationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  count = %[2]d
+
+  bucket = "%[1]s-${count.index}"
+}
+
+resource "aws_shield_drt_access_log_bucket_association" "test" {
+  log_buckets             = aws_s3_bucket.test[*].id
+  role_arn_association_id = aws_shield_drt_access_role_arn_association.test.id
+}
+`, rName, bucketCount))
+}
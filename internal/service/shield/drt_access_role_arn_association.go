@@ -0,0 +1,107 @@
+package shield
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_shield_drt_access_role_arn_association")
+func ResourceDRTAccessRoleARNAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDRTAccessRoleARNAssociationPut,
+		ReadWithoutTimeout:   resourceDRTAccessRoleARNAssociationRead,
+		UpdateWithoutTimeout: resourceDRTAccessRoleARNAssociationPut,
+		DeleteWithoutTimeout: resourceDRTAccessRoleARNAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDRTAccessRoleARNAssociationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	roleARN := d.Get("role_arn").(string)
+	input := &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleARN),
+	}
+
+	// A newly created role's trust relationship with the DRT service principal may not have propagated yet.
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateDRTRoleWithContext(ctx, input)
+	}, shield.ErrCodeInvalidParameterException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "associating Shield DRT role (%s): %s", roleARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return append(diags, resourceDRTAccessRoleARNAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDRTAccessRoleARNAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	output, err := FindDRTAccess(ctx, conn)
+
+	if err == nil && aws.StringValue(output.RoleArn) == "" {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield DRT Role ARN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Shield DRT Role ARN Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("role_arn", output.RoleArn)
+
+	return diags
+}
+
+func resourceDRTAccessRoleARNAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	log.Printf("[DEBUG] Deleting Shield DRT Role ARN Association: %s", d.Id())
+	_, err := conn.DisassociateDRTRoleWithContext(ctx, &shield.DisassociateDRTRoleInput{})
+
+	// ResourceNotFoundException is returned when Shield Advanced has been unsubscribed.
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Shield DRT Role ARN Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,34 @@
+package shield
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDRTAccess(ctx context.Context, conn *shield.Shield) (*shield.DescribeDRTAccessOutput, error) {
+	input := &shield.DescribeDRTAccessInput{}
+
+	output, err := conn.DescribeDRTAccessWithContext(ctx, input)
+
+	// ResourceNotFoundException is also returned when the account isn't subscribed to Shield Advanced.
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -25,6 +25,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDRTAccessLogBucketAssociation,
+			TypeName: "aws_shield_drt_access_log_bucket_association",
+		},
+		{
+			Factory:  ResourceDRTAccessRoleARNAssociation,
+			TypeName: "aws_shield_drt_access_role_arn_association",
+		},
 		{
 			Factory:  ResourceProtection,
 			TypeName: "aws_shield_protection",
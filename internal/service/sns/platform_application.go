@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -21,17 +23,25 @@ import (
 var (
 	platformApplicationSchema = map[string]*schema.Schema{
 		"apple_platform_bundle_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z.-]+$`), "must only contain alphanumeric characters, hyphens (-) and periods (.)"),
+			RequiredWith: []string{"apple_platform_team_id"},
 		},
 		"apple_platform_team_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]{10}$`), "must be 10 alphanumeric characters"),
+			RequiredWith: []string{"apple_platform_bundle_id"},
 		},
 		"arn": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"credentials_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
 		"event_delivery_failure_topic_arn": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -94,7 +104,7 @@ var (
 		"platform_principal":               PlatformApplicationAttributeNamePlatformPrincipal,
 		"success_feedback_role_arn":        PlatformApplicationAttributeNameSuccessFeedbackRoleARN,
 		"success_feedback_sample_rate":     PlatformApplicationAttributeNameSuccessFeedbackSampleRate,
-	}, platformApplicationSchema).WithSkipUpdate("apple_platform_bundle_id").WithSkipUpdate("apple_platform_team_id").WithSkipUpdate("platform_credential").WithSkipUpdate("platform_principal")
+	}, platformApplicationSchema).WithSkipUpdate("apple_platform_bundle_id").WithSkipUpdate("apple_platform_team_id").WithSkipUpdate("platform_credential").WithSkipUpdate("platform_principal").WithMissingSetToNil("apple_platform_bundle_id").WithMissingSetToNil("apple_platform_team_id")
 )
 
 // @SDKResource("aws_sns_platform_application")
@@ -189,10 +199,10 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "platform_credential", "platform_principal") {
+	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "credentials_version", "platform_credential", "platform_principal") {
 		// If APNS platform was configured with token-based authentication then the only way to update them
 		// is to update all 4 attributes as they must be specified together in the request.
-		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") {
+		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") || (d.HasChange("credentials_version") && d.Get("apple_platform_team_id").(string) != "") {
 			attributes[PlatformApplicationAttributeNameApplePlatformTeamID] = d.Get("apple_platform_team_id").(string)
 			attributes[PlatformApplicationAttributeNameApplePlatformBundleID] = d.Get("apple_platform_bundle_id").(string)
 		}
@@ -203,7 +213,7 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		oPCRaw, nPCRaw := d.GetChange("platform_credential")
 		oPPRaw, nPPRaw := d.GetChange("platform_principal")
 
-		if len(attributes) == 0 && isChangeSha256Removal(oPCRaw, nPCRaw) && isChangeSha256Removal(oPPRaw, nPPRaw) {
+		if len(attributes) == 0 && !d.HasChange("credentials_version") && isChangeSha256Removal(oPCRaw, nPCRaw) && isChangeSha256Removal(oPPRaw, nPPRaw) {
 			return nil
 		}
 
@@ -215,6 +215,47 @@ func TestAccSNSPlatformApplication_GCM_basic(t *testing.T) {
 	})
 }
 
+func TestAccSNSPlatformApplication_GCM_credentialsVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "GCM_API_KEY"
+	apiKey := os.Getenv(key)
+	if apiKey == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_sns_platform_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlatformApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlatformApplicationConfig_gcmCredentialsVersion(rName, apiKey, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlatformApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "credentials_version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_version", "platform_credential", "platform_principal"},
+			},
+			{
+				Config: testAccPlatformApplicationConfig_gcmCredentialsVersion(rName, apiKey, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlatformApplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "credentials_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSNSPlatformApplication_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "GCM_API_KEY"
@@ -518,6 +559,17 @@ resource "aws_sns_platform_application" "test" {
 `, rName, credentials)
 }
 
+func testAccPlatformApplicationConfig_gcmCredentialsVersion(rName, credentials, credentialsVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                = %[1]q
+  platform            = "GCM"
+  platform_credential = %[2]q
+  credentials_version = %[3]q
+}
+`, rName, credentials, credentialsVersion)
+}
+
 func testAccPlatformApplicationConfig_gcmAllAttributesBase(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -7,3 +7,9 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	serviceSettingStatusCustomized    = "Customized"
+	serviceSettingStatusDefault       = "Default"
+	serviceSettingStatusPendingUpdate = "PendingUpdate"
+)
@@ -0,0 +1,178 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameDefaultHostManagementConfiguration = "Default Host Management Configuration"
+
+	defaultHostManagementConfigurationServicePrincipal = "ssm.amazonaws.com"
+	defaultHostManagementConfigurationSettingID        = "/ssm/managed-instance/default-ec2-instance-management-role"
+)
+
+// @SDKResource("aws_ssm_default_host_management_configuration")
+func ResourceDefaultHostManagementConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDefaultHostManagementConfigurationPut,
+		ReadWithoutTimeout:   resourceDefaultHostManagementConfigurationRead,
+		UpdateWithoutTimeout: resourceDefaultHostManagementConfigurationPut,
+		DeleteWithoutTimeout: resourceDefaultHostManagementConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffDefaultHostManagementConfigurationRole,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDefaultHostManagementConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	input := &ssm.UpdateServiceSettingInput{
+		SettingId:    aws.String(defaultHostManagementConfigurationSettingID),
+		SettingValue: aws.String(d.Get("role_name").(string)),
+	}
+
+	if _, err := conn.UpdateServiceSettingWithContext(ctx, input); err != nil {
+		return create.DiagError(names.SSM, create.ErrActionUpdating, ResNameDefaultHostManagementConfiguration, meta.(*conns.AWSClient).Region, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitServiceSettingUpdated(ctx, conn, defaultHostManagementConfigurationSettingID, timeout); err != nil {
+		return create.DiagError(names.SSM, create.ErrActionWaitingForUpdate, ResNameDefaultHostManagementConfiguration, d.Id(), err)
+	}
+
+	return append(diags, resourceDefaultHostManagementConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceDefaultHostManagementConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	output, err := FindServiceSettingByID(ctx, conn, defaultHostManagementConfigurationSettingID)
+
+	// A setting that has been reset to its default no longer references a role.
+	if err == nil && aws.StringValue(output.Status) == serviceSettingStatusDefault {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Default Host Management Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSM, create.ErrActionReading, ResNameDefaultHostManagementConfiguration, d.Id(), err)
+	}
+
+	d.Set("arn", output.ARN)
+	d.Set("role_name", output.SettingValue)
+	d.Set("status", output.Status)
+
+	return diags
+}
+
+func resourceDefaultHostManagementConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[DEBUG] Deleting SSM Default Host Management Configuration: %s", d.Id())
+	_, err := conn.ResetServiceSettingWithContext(ctx, &ssm.ResetServiceSettingInput{
+		SettingId: aws.String(defaultHostManagementConfigurationSettingID),
+	})
+
+	if err != nil {
+		return create.DiagError(names.SSM, create.ErrActionDeleting, ResNameDefaultHostManagementConfiguration, d.Id(), err)
+	}
+
+	if err := waitServiceSettingReset(ctx, conn, defaultHostManagementConfigurationSettingID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.SSM, create.ErrActionWaitingForDeletion, ResNameDefaultHostManagementConfiguration, d.Id(), err)
+	}
+
+	return diags
+}
+
+// customizeDiffDefaultHostManagementConfigurationRole checks, on a best-effort basis, that the
+// role's trust policy allows Systems Manager to assume it.
+// Roles that don't exist yet or can't be read are not checked.
+func customizeDiffDefaultHostManagementConfigurationRole(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("role_name") || !diff.NewValueKnown("role_name") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).IAMConn()
+	roleName := diff.Get("role_name").(string)
+
+	// The setting value may include the role's path, e.g. "service-role/AWSSystemsManagerDefaultEC2InstanceManagementRole".
+	if i := strings.LastIndex(roleName, "/"); i >= 0 {
+		roleName = roleName[i+1:]
+	}
+
+	role, err := tfiam.FindRoleByName(ctx, conn, roleName)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to verify trust policy of IAM Role (%s): %s", roleName, err)
+		return nil
+	}
+
+	policy, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+
+	if err != nil {
+		log.Printf("[WARN] Unable to verify trust policy of IAM Role (%s): %s", roleName, err)
+		return nil
+	}
+
+	if !strings.Contains(policy, defaultHostManagementConfigurationServicePrincipal) {
+		return fmt.Errorf("IAM Role (%s) trust policy does not allow %s to assume the role", roleName, defaultHostManagementConfigurationServicePrincipal)
+	}
+
+	return nil
+}
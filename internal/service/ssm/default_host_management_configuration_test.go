@@ -0,0 +1,227 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSSMDefaultHostManagementConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var setting ssm.ServiceSetting
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_default_host_management_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultHostManagementConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultHostManagementConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultHostManagementConfigurationExists(ctx, resourceName, &setting),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ssm", "servicesetting/ssm/managed-instance/default-ec2-instance-management-role"),
+					resource.TestCheckResourceAttr(resourceName, "role_name", fmt.Sprintf("service-role/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "status", "Customized"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSSMDefaultHostManagementConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var setting ssm.ServiceSetting
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_default_host_management_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultHostManagementConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultHostManagementConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultHostManagementConfigurationExists(ctx, resourceName, &setting),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceDefaultHostManagementConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSSMDefaultHostManagementConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var setting ssm.ServiceSetting
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_default_host_management_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultHostManagementConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultHostManagementConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultHostManagementConfigurationExists(ctx, resourceName, &setting),
+					resource.TestCheckResourceAttr(resourceName, "role_name", fmt.Sprintf("service-role/%s", rName)),
+				),
+			},
+			{
+				Config: testAccDefaultHostManagementConfigurationConfig_basic(rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultHostManagementConfigurationExists(ctx, resourceName, &setting),
+					resource.TestCheckResourceAttr(resourceName, "role_name", fmt.Sprintf("service-role/%s", rName2)),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSMDefaultHostManagementConfiguration_untrustedRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultHostManagementConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			// Create the role first so that its trust policy can be checked at plan time.
+			{
+				Config: testAccDefaultHostManagementConfigurationConfig_role(rName, "ec2.amazonaws.com"),
+			},
+			{
+				Config:      testAccDefaultHostManagementConfigurationConfig_untrustedRole(rName),
+				ExpectError: regexp.MustCompile(`trust policy does not allow ssm.amazonaws.com to assume the role`),
+			},
+		},
+	})
+}
+
+func testAccCheckDefaultHostManagementConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_default_host_management_configuration" {
+				continue
+			}
+
+			output, err := tfssm.FindServiceSettingByID(ctx, conn, "/ssm/managed-instance/default-ec2-instance-management-role")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(output.Status) == "Default" {
+				continue
+			}
+
+			return create.Error(names.SSM, create.ErrActionCheckingDestroyed, tfssm.ResNameDefaultHostManagementConfiguration, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDefaultHostManagementConfigurationExists(ctx context.Context, n string, v *ssm.ServiceSetting) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Default Host Management Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		output, err := tfssm.FindServiceSettingByID(ctx, conn, "/ssm/managed-instance/default-ec2-instance-management-role")
+
+		if err != nil {
+			return create.Error(names.SSM, create.ErrActionReading, tfssm.ResNameDefaultHostManagementConfiguration, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDefaultHostManagementConfigurationConfig_role(rName, servicePrincipal string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/service-role/"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = %[2]q
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSSMManagedEC2InstanceDefaultPolicy"
+}
+`, rName, servicePrincipal)
+}
+
+func testAccDefaultHostManagementConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDefaultHostManagementConfigurationConfig_role(rName, "ssm.amazonaws.com"), `
+resource "aws_ssm_default_host_management_configuration" "test" {
+  role_name = "service-role/${aws_iam_role.test.name}"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`)
+}
+
+func testAccDefaultHostManagementConfigurationConfig_untrustedRole(rName string) string {
+	return acctest.ConfigCompose(testAccDefaultHostManagementConfigurationConfig_role(rName, "ec2.amazonaws.com"), `
+resource "aws_ssm_default_host_management_configuration" "test" {
+  role_name = "service-role/${aws_iam_role.test.name}"
+}
+`)
+}
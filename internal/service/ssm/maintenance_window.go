@@ -2,12 +2,15 @@ package ssm
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -89,10 +92,24 @@ func ResourceMaintenanceWindow() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceMaintenanceWindowCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceMaintenanceWindowCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// ValidationException: ScheduleOffset can only be specified with a cron expression.
+	if v, ok := diff.GetOk("schedule_offset"); ok && v.(int) > 0 {
+		if schedule := diff.Get("schedule").(string); schedule != "" && !strings.HasPrefix(schedule, "cron(") {
+			return fmt.Errorf("schedule_offset can only be specified with a cron schedule, got %q", schedule)
+		}
+	}
+
+	return nil
+}
+
 func resourceMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
@@ -3,6 +3,7 @@ package ssm_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -456,6 +457,10 @@ func TestAccSSMMaintenanceWindow_scheduleOffset(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "5"),
 				),
 			},
+			{
+				Config:      testAccMaintenanceWindowConfig_scheduleOffsetRate(rName, 2),
+				ExpectError: regexp.MustCompile(`schedule_offset can only be specified with a cron schedule`),
+			},
 		},
 	})
 }
@@ -733,6 +738,18 @@ resource "aws_ssm_maintenance_window" "test" {
 `, rName, scheduleOffset)
 }
 
+func testAccMaintenanceWindowConfig_scheduleOffsetRate(rName string, scheduleOffset int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_maintenance_window" "test" {
+  cutoff          = 1
+  duration        = 3
+  name            = %q
+  schedule        = "rate(7 days)"
+  schedule_offset = %d
+}
+`, rName, scheduleOffset)
+}
+
 func testAccMaintenanceWindowConfig_startDate(rName, startDate string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_maintenance_window" "test" {
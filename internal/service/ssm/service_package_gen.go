@@ -58,6 +58,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceAssociation,
 			TypeName: "aws_ssm_association",
 		},
+		{
+			Factory:  ResourceDefaultHostManagementConfiguration,
+			TypeName: "aws_ssm_default_host_management_configuration",
+		},
 		{
 			Factory:  ResourceDefaultPatchBaseline,
 			TypeName: "aws_ssm_default_patch_baseline",
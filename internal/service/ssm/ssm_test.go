@@ -11,6 +11,12 @@ func TestAccSSM_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DefaultHostManagementConfiguration": {
+			"basic":         testAccSSMDefaultHostManagementConfiguration_basic,
+			"disappears":    testAccSSMDefaultHostManagementConfiguration_disappears,
+			"untrustedRole": testAccSSMDefaultHostManagementConfiguration_untrustedRole,
+			"update":        testAccSSMDefaultHostManagementConfiguration_update,
+		},
 		"DefaultPatchBaseline": {
 			"basic":                testAccSSMDefaultPatchBaseline_basic,
 			"disappears":           testAccSSMDefaultPatchBaseline_disappears,
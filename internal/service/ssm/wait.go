@@ -74,8 +74,8 @@ func waitDocumentActive(ctx context.Context, conn *ssm.SSM, name string) (*ssm.D
 
 func waitServiceSettingUpdated(ctx context.Context, conn *ssm.SSM, id string, timeout time.Duration) (*ssm.ServiceSetting, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PendingUpdate", ""},
-		Target:  []string{"Customized", "Default"},
+		Pending: []string{serviceSettingStatusPendingUpdate, ""},
+		Target:  []string{serviceSettingStatusCustomized, serviceSettingStatusDefault},
 		Refresh: statusServiceSetting(ctx, conn, id),
 		Timeout: timeout,
 	}
@@ -91,8 +91,8 @@ func waitServiceSettingUpdated(ctx context.Context, conn *ssm.SSM, id string, ti
 
 func waitServiceSettingReset(ctx context.Context, conn *ssm.SSM, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"Customized", "PendingUpdate", ""},
-		Target:  []string{"Default"},
+		Pending: []string{serviceSettingStatusCustomized, serviceSettingStatusPendingUpdate, ""},
+		Target:  []string{serviceSettingStatusDefault},
 		Refresh: statusServiceSetting(ctx, conn, id),
 		Timeout: timeout,
 	}
@@ -57,14 +57,66 @@ func ResourceGateway() *schema.Resource {
 				Computed: true,
 			},
 			"average_download_rate_limit_in_bits_per_sec": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(102400),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(102400),
+				ConflictsWith: []string{"bandwidth_rate_limit_interval"},
 			},
 			"average_upload_rate_limit_in_bits_per_sec": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(51200),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(51200),
+				ConflictsWith: []string{"bandwidth_rate_limit_interval"},
+			},
+			"bandwidth_rate_limit_interval": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      20,
+				ConflictsWith: []string{"average_download_rate_limit_in_bits_per_sec", "average_upload_rate_limit_in_bits_per_sec"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_download_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(102400),
+						},
+						"average_upload_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(51200),
+						},
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"end_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"start_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
 			},
 			"cloudwatch_log_group_arn": {
 				Type:         schema.TypeString,
@@ -264,11 +316,25 @@ func ResourceGateway() *schema.Resource {
 			customdiff.ForceNewIfChange("smb_active_directory_settings", func(_ context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			resourceGatewayMaintenanceStartTimeCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+func resourceGatewayMaintenanceStartTimeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Both attributes are nullable and may be explicitly configured as "", so ConflictsWith cannot be used.
+	if v, ok := diff.GetOk("maintenance_start_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if tfMap["day_of_week"].(string) != "" && tfMap["day_of_month"].(string) != "" {
+			return fmt.Errorf(`only one of "maintenance_start_time.0.day_of_week" or "maintenance_start_time.0.day_of_month" can be specified`)
+		}
+	}
+
+	return nil
+}
+
 func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).StorageGatewayConn()
@@ -479,6 +545,19 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("bandwidth_rate_limit_interval"); ok && len(v.([]interface{})) > 0 {
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(v.([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Storage Gateway Gateway %q setting Bandwidth Rate Limit Schedule: %s", d.Id(), input)
+		_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Bandwidth Rate Limit Schedule: %s", err)
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -628,6 +707,33 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// A schedule is only tracked when the gateway-wide rate limits are not in use,
+	// as the latter are also reported as an interval covering the whole week.
+	if _, ok := d.GetOk("average_download_rate_limit_in_bits_per_sec"); !ok {
+		if _, ok := d.GetOk("average_upload_rate_limit_in_bits_per_sec"); !ok {
+			scheduleOutput, err := conn.DescribeBandwidthRateLimitScheduleWithContext(ctx, &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+				GatewayARN: aws.String(d.Id()),
+			})
+
+			if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not supported") ||
+				tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "not valid") {
+				err = nil
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Bandwidth rate limit schedule: %s", err)
+			}
+
+			if scheduleOutput != nil {
+				if err := d.Set("bandwidth_rate_limit_interval", flattenBandwidthRateLimitIntervals(scheduleOutput.BandwidthRateLimitIntervals)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "setting bandwidth_rate_limit_interval: %s", err)
+				}
+			} else {
+				d.Set("bandwidth_rate_limit_interval", nil)
+			}
+		}
+	}
+
 	maintenanceStartTimeOutput, err := conn.DescribeMaintenanceStartTimeWithContext(ctx, &storagegateway.DescribeMaintenanceStartTimeInput{
 		GatewayARN: aws.String(d.Id()),
 	})
@@ -791,6 +897,21 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("bandwidth_rate_limit_interval") {
+		// An empty list of intervals removes the schedule.
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(d.Get("bandwidth_rate_limit_interval").([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Storage Gateway bandwidth rate limit schedule: %s", input)
+		_, err := conn.UpdateBandwidthRateLimitScheduleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -884,7 +1005,7 @@ func expandUpdateMaintenanceStartTimeInput(tfMap map[string]interface{}) *storag
 		apiObject.DayOfMonth = aws.Int64(v)
 	}
 
-	if v, null, _ := nullable.Int(tfMap["day_of_week"].(string)).Value(); !null && v > 0 {
+	if v, null, _ := nullable.Int(tfMap["day_of_week"].(string)).Value(); !null && v >= 0 {
 		apiObject.DayOfWeek = aws.Int64(v)
 	}
 
@@ -925,6 +1046,60 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 	return tfMap
 }
 
+func expandBandwidthRateLimitIntervals(tfList []interface{}) []*storagegateway.BandwidthRateLimitInterval {
+	apiObjects := []*storagegateway.BandwidthRateLimitInterval{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &storagegateway.BandwidthRateLimitInterval{
+			DaysOfWeek:        flex.ExpandInt64Set(tfMap["days_of_week"].(*schema.Set)),
+			EndHourOfDay:      aws.Int64(int64(tfMap["end_hour_of_day"].(int))),
+			EndMinuteOfHour:   aws.Int64(int64(tfMap["end_minute_of_hour"].(int))),
+			StartHourOfDay:    aws.Int64(int64(tfMap["start_hour_of_day"].(int))),
+			StartMinuteOfHour: aws.Int64(int64(tfMap["start_minute_of_hour"].(int))),
+		}
+
+		if v, ok := tfMap["average_download_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["average_upload_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBandwidthRateLimitIntervals(apiObjects []*storagegateway.BandwidthRateLimitInterval) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"average_download_rate_limit_in_bits_per_sec": aws.Int64Value(apiObject.AverageDownloadRateLimitInBitsPerSec),
+			"average_upload_rate_limit_in_bits_per_sec":   aws.Int64Value(apiObject.AverageUploadRateLimitInBitsPerSec),
+			"days_of_week":         flex.FlattenInt64Set(apiObject.DaysOfWeek),
+			"end_hour_of_day":      aws.Int64Value(apiObject.EndHourOfDay),
+			"end_minute_of_hour":   aws.Int64Value(apiObject.EndMinuteOfHour),
+			"start_hour_of_day":    aws.Int64Value(apiObject.StartHourOfDay),
+			"start_minute_of_hour": aws.Int64Value(apiObject.StartMinuteOfHour),
+		})
+	}
+
+	return tfList
+}
+
 // The API returns multiple responses for a missing gateway
 func IsErrGatewayNotFound(err error) bool {
 	if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway was not found.") {
@@ -854,6 +854,61 @@ func TestAccStorageGatewayGateway_maintenanceStartTime(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.day_of_month", "12"),
 				),
 			},
+			{
+				Config:      testAccGatewayConfig_maintenanceStartTime(rName, 21, 10, "1", "12"),
+				ExpectError: regexp.MustCompile(`only one of "maintenance_start_time.0.day_of_week" or "maintenance_start_time.0.day_of_month" can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_bandwidthRateLimitInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, storagegateway.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_upload_rate_limit_in_bits_per_sec", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_hour_of_day", "17"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_minute_of_hour", "59"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 204800),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "204800"),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_typeFileS3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -1457,6 +1512,26 @@ resource "aws_storagegateway_gateway" "test" {
 `, rName, rate))
 }
 
+func testAccGatewayConfig_bandwidthRateLimitInterval(rName string, rate int) string {
+	return acctest.ConfigCompose(testAcc_FileGatewayBase(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+
+  bandwidth_rate_limit_interval {
+    days_of_week                                = [1, 2, 3, 4, 5]
+    start_hour_of_day                           = 9
+    start_minute_of_hour                        = 0
+    end_hour_of_day                             = 17
+    end_minute_of_hour                          = 59
+    average_download_rate_limit_in_bits_per_sec = %[2]d
+  }
+}
+`, rName, rate))
+}
+
 func testAccGatewayConfig_maintenanceStartTime(rName string, hourOfDay, minuteOfHour int, dayOfWeek, dayOfMonth string) string {
 	if dayOfWeek == "" {
 		dayOfWeek = strconv.Quote(dayOfWeek)
@@ -0,0 +1,110 @@
+package transfer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_transfer_identity_provider_test")
+func DataSourceIdentityProviderTest() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIdentityProviderTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validServerID,
+			},
+			"server_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(transfer.Protocol_Values(), false),
+			},
+			"source_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"status_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validUserName,
+			},
+			"user_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityProviderTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	serverID := d.Get("server_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &transfer.TestIdentityProviderInput{
+		ServerId: aws.String(serverID),
+		UserName: aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("server_protocol"); ok {
+		input.ServerProtocol = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		input.SourceIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_password"); ok {
+		input.UserPassword = aws.String(v.(string))
+	}
+
+	output, err := conn.TestIdentityProviderWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Server (%s) identity provider: %s", serverID, err)
+	}
+
+	// A non-200 status code or an empty response means that the identity provider
+	// rejected the credentials or returned a malformed response.
+	if statusCode := aws.Int64Value(output.StatusCode); statusCode != http.StatusOK || aws.StringValue(output.Response) == "" {
+		return sdkdiag.AppendErrorf(diags, "testing Transfer Server (%s) identity provider for user (%s): invalid response (status code: %d): %s", serverID, userName, statusCode, aws.StringValue(output.Message))
+	}
+
+	d.SetId(serverID)
+	d.Set("message", output.Message)
+	d.Set("response", output.Response)
+	d.Set("status_code", output.StatusCode)
+	d.Set("url", output.Url)
+
+	return diags
+}
@@ -0,0 +1,50 @@
+package transfer_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccIdentityProviderTestDataSource_lambdaInvalidResponse(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The test Lambda function doesn't return a Transfer Family authentication response.
+				Config:      testAccIdentityProviderTestDataSourceConfig_lambda(rName),
+				ExpectError: regexp.MustCompile(`invalid response`),
+			},
+		},
+	})
+}
+
+func testAccIdentityProviderTestDataSourceConfig_lambda(rName string) string {
+	return acctest.ConfigCompose(testAccServerConfig_lambdaFunctionIdentityProviderType(rName, true), fmt.Sprintf(`
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "transfer.amazonaws.com"
+  source_arn    = aws_transfer_server.test.arn
+}
+
+data "aws_transfer_identity_provider_test" "test" {
+  server_id       = aws_transfer_server.test.id
+  server_protocol = "SFTP"
+  user_name       = %[1]q
+  user_password   = "Password123!"
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName))
+}
@@ -21,6 +21,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceIdentityProviderTest,
+			TypeName: "aws_transfer_identity_provider_test",
+		},
 		{
 			Factory:  DataSourceServer,
 			TypeName: "aws_transfer_server",
@@ -16,6 +16,9 @@ func TestAccTransfer_serial(t *testing.T) {
 			"S3Basic":    testAccAccess_s3_basic,
 			"S3Policy":   testAccAccess_s3_policy,
 		},
+		"IdentityProviderTest": {
+			"DataSourceLambdaInvalidResponse": testAccIdentityProviderTestDataSource_lambdaInvalidResponse,
+		},
 		"Server": {
 			"basic":                         testAccServer_basic,
 			"disappears":                    testAccServer_disappears,
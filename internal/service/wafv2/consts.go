@@ -4,3 +4,15 @@ const (
 	ruleGroupRootStatementSchemaLevel = 3
 	webACLRootStatementSchemaLevel    = 3
 )
+
+const (
+	ipSetManageAddressesExclusive   = "exclusive"
+	ipSetManageAddressesIncremental = "incremental"
+)
+
+func ipSetManageAddresses_Values() []string {
+	return []string{
+		ipSetManageAddressesExclusive,
+		ipSetManageAddressesIncremental,
+	}
+}
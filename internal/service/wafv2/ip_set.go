@@ -23,6 +23,7 @@ import (
 
 const (
 	ipSetDeleteTimeout = 5 * time.Minute
+	ipSetUpdateTimeout = 5 * time.Minute
 )
 
 // @SDKResource("aws_wafv2_ip_set")
@@ -77,6 +78,10 @@ func ResourceIPSet() *schema.Resource {
 					return false
 				},
 			},
+			"address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -96,6 +101,12 @@ func ResourceIPSet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"manage_addresses": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ipSetManageAddressesExclusive,
+				ValidateFunc: validation.StringInSlice(ipSetManageAddresses_Values(), false),
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -171,12 +182,23 @@ func resourceIPSetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	ipSet := output.IPSet
-	d.Set("addresses", aws.StringValueSlice(ipSet.Addresses))
+	addresses := aws.StringValueSlice(ipSet.Addresses)
+	manageAddresses := ipSetManageAddressesExclusive
+	if v, ok := d.GetOk("manage_addresses"); ok {
+		manageAddresses = v.(string)
+	}
+	if manageAddresses == ipSetManageAddressesIncremental {
+		// Only report the configured addresses that are present, other addresses are managed elsewhere.
+		addresses = ipSetAddressesIntersection(flex.ExpandStringValueSet(d.Get("addresses").(*schema.Set)), addresses)
+	}
+	d.Set("address_count", len(ipSet.Addresses))
+	d.Set("addresses", addresses)
 	arn := aws.StringValue(ipSet.ARN)
 	d.Set("arn", arn)
 	d.Set("description", ipSet.Description)
 	d.Set("ip_address_version", ipSet.IPAddressVersion)
 	d.Set("lock_token", output.LockToken)
+	d.Set("manage_addresses", manageAddresses)
 	d.Set("name", ipSet.Name)
 
 	tags, err := ListTags(ctx, conn, arn)
@@ -202,7 +224,11 @@ func resourceIPSetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 func resourceIPSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "manage_addresses") && d.Get("manage_addresses").(string) == ipSetManageAddressesIncremental {
+		if err := updateIPSetAddressesIncremental(ctx, conn, d); err != nil {
+			return diag.Errorf("updating WAFv2 IPSet (%s): %s", d.Id(), err)
+		}
+	} else if d.HasChangesExcept("tags", "tags_all", "manage_addresses") {
 		input := &wafv2.UpdateIPSetInput{
 			Addresses: aws.StringSlice([]string{}),
 			Id:        aws.String(d.Id()),
@@ -265,6 +291,75 @@ func resourceIPSetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// updateIPSetAddressesIncremental adds the configured addresses to, and removes the addresses no longer configured from,
+// the IP set's current addresses. Addresses added outside of Terraform are kept.
+// The IP set is re-read and the update retried if it is modified concurrently.
+func updateIPSetAddressesIncremental(ctx context.Context, conn *wafv2.WAFV2, d *schema.ResourceData) error {
+	o, n := d.GetChange("addresses")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add, del := flex.ExpandStringValueSet(ns), flex.ExpandStringValueSet(os.Difference(ns))
+	name, scope := d.Get("name").(string), d.Get("scope").(string)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, ipSetUpdateTimeout, func() (interface{}, error) {
+		output, err := FindIPSetByThreePartKey(ctx, conn, d.Id(), name, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var addresses []string
+		for _, v := range aws.StringValueSlice(output.IPSet.Addresses) {
+			if !ipSetAddressesContain(del, v) {
+				addresses = append(addresses, v)
+			}
+		}
+		for _, v := range add {
+			if !ipSetAddressesContain(addresses, v) {
+				addresses = append(addresses, v)
+			}
+		}
+
+		input := &wafv2.UpdateIPSetInput{
+			Addresses: aws.StringSlice(addresses),
+			Id:        aws.String(d.Id()),
+			LockToken: output.LockToken,
+			Name:      aws.String(name),
+			Scope:     aws.String(scope),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[INFO] Updating WAFv2 IPSet: %s", d.Id())
+		return conn.UpdateIPSetWithContext(ctx, input)
+	}, wafv2.ErrCodeWAFOptimisticLockException)
+
+	return err
+}
+
+func ipSetAddressesContain(addresses []string, address string) bool {
+	for _, v := range addresses {
+		if verify.CIDRBlocksEqual(v, address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ipSetAddressesIntersection(configured, actual []string) []string {
+	var addresses []string
+
+	for _, v := range configured {
+		if ipSetAddressesContain(actual, v) {
+			addresses = append(addresses, v)
+		}
+	}
+
+	return addresses
+}
+
 func FindIPSetByThreePartKey(ctx context.Context, conn *wafv2.WAFV2, id, name, scope string) (*wafv2.GetIPSetOutput, error) {
 	input := &wafv2.GetIPSetInput{
 		Id:    aws.String(id),
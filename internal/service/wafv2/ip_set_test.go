@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -65,6 +66,42 @@ func TestAccWAFV2IPSet_basic(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_manageAddressesIncremental(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v wafv2.IPSet
+	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	resourceName := "aws_wafv2_ip_set.ip_set"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPSetConfig_manageAddressesIncremental(ipSetName, `"1.2.3.4/32", "5.6.7.8/32"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manage_addresses", "incremental"),
+					testAccCheckIPSetAddAddress(ctx, resourceName, "10.0.0.1/32"),
+				),
+			},
+			{
+				Config: testAccIPSetConfig_manageAddressesIncremental(ipSetName, `"1.2.3.4/32", "9.9.9.9/32"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "address_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "1.2.3.4/32"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "9.9.9.9/32"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccWAFV2IPSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var r wafv2.IPSet
@@ -447,6 +484,46 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name)
 }
 
+func testAccIPSetConfig_manageAddressesIncremental(name, addresses string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_ip_set" "ip_set" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = "IPV4"
+  addresses          = [%[2]s]
+  manage_addresses   = "incremental"
+}
+`, name, addresses)
+}
+
+// testAccCheckIPSetAddAddress adds an address outside of Terraform.
+func testAccCheckIPSetAddAddress(ctx context.Context, n, address string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
+
+		output, err := tfwafv2.FindIPSetByThreePartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["name"], rs.Primary.Attributes["scope"])
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.UpdateIPSetWithContext(ctx, &wafv2.UpdateIPSetInput{
+			Addresses: append(output.IPSet.Addresses, aws.String(address)),
+			Id:        output.IPSet.Id,
+			LockToken: output.LockToken,
+			Name:      output.IPSet.Name,
+			Scope:     aws.String(rs.Primary.Attributes["scope"]),
+		})
+
+		return err
+	}
+}
+
 func testAccIPSetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
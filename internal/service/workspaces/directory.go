@@ -33,6 +33,27 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.CertificateBasedAuthStatusEnumDisabled,
+							ValidateFunc: validation.StringInSlice(workspaces.CertificateBasedAuthStatusEnum_Values(), false),
+						},
+					},
+				},
+			},
 			"customer_user_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,6 +90,33 @@ func ResourceDirectory() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"saml_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relay_state_parameter_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "RelayState",
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      workspaces.SamlStatusEnumDisabled,
+							ValidateFunc: validation.StringInSlice(workspaces.SamlStatusEnum_Values(), false),
+						},
+						"user_access_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(8, 200), validation.IsURLWithHTTPorHTTPS),
+						},
+					},
+				},
+			},
 			"self_service_permissions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -282,6 +330,31 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", directoryID)
 	}
 
+	if v, ok := d.GetOk("saml_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", directoryID)
+		_, err := conn.ModifySamlPropertiesWithContext(ctx, &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(directoryID),
+			SamlProperties: ExpandSAMLProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) SAML properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", directoryID)
+	}
+
+	// Certificate-based authentication requires SAML authentication, so it's configured after the SAML properties.
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+		_, err := conn.ModifyCertificateBasedAuthPropertiesWithContext(ctx, &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(directoryID),
+			CertificateBasedAuthProperties: ExpandCertificateBasedAuthProperties(v.([]interface{})),
+		})
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) certificate-based authentication properties: %s", directoryID, err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", directoryID)
+	}
+
 	if v, ok := d.GetOk("ip_group_ids"); ok && v.(*schema.Set).Len() > 0 {
 		ipGroupIds := v.(*schema.Set)
 		log.Printf("[DEBUG] Associating WorkSpaces Directory (%s) with IP Groups %s", directoryID, ipGroupIds.List())
@@ -339,6 +412,14 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting workspace_creation_properties: %s", err)
 	}
 
+	if err := d.Set("saml_properties", FlattenSAMLProperties(directory.SamlProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting saml_properties: %s", err)
+	}
+
+	if err := d.Set("certificate_based_auth_properties", FlattenCertificateBasedAuthProperties(directory.CertificateBasedAuthProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_based_auth_properties: %s", err)
+	}
+
 	if err := d.Set("ip_group_ids", flex.FlattenStringSet(directory.IpGroupIds)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ip_group_ids: %s", err)
 	}
@@ -412,6 +493,58 @@ func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		log.Printf("[INFO] Modified WorkSpaces Directory (%s) creation properties", d.Id())
 	}
 
+	if d.HasChange("saml_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) SAML properties", d.Id())
+		properties := d.Get("saml_properties").([]interface{})
+
+		input := &workspaces.ModifySamlPropertiesInput{
+			ResourceId:     aws.String(d.Id()),
+			SamlProperties: ExpandSAMLProperties(properties),
+		}
+
+		if len(properties) > 0 && properties[0] != nil {
+			p := properties[0].(map[string]interface{})
+
+			if p["relay_state_parameter_name"].(string) == "" {
+				input.PropertiesToDelete = append(input.PropertiesToDelete, aws.String(workspaces.DeletableSamlPropertySamlPropertiesRelayStateParameterName))
+			}
+
+			if p["user_access_url"].(string) == "" {
+				input.PropertiesToDelete = append(input.PropertiesToDelete, aws.String(workspaces.DeletableSamlPropertySamlPropertiesUserAccessUrl))
+			}
+		}
+
+		_, err := conn.ModifySamlPropertiesWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) SAML properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) SAML properties", d.Id())
+	}
+
+	if d.HasChange("certificate_based_auth_properties") {
+		log.Printf("[DEBUG] Modifying WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+		properties := d.Get("certificate_based_auth_properties").([]interface{})
+
+		input := &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			ResourceId:                     aws.String(d.Id()),
+			CertificateBasedAuthProperties: ExpandCertificateBasedAuthProperties(properties),
+		}
+
+		if len(properties) > 0 && properties[0] != nil {
+			p := properties[0].(map[string]interface{})
+
+			if p["certificate_authority_arn"].(string) == "" {
+				input.PropertiesToDelete = append(input.PropertiesToDelete, aws.String(workspaces.DeletableCertificateBasedAuthPropertyCertificateBasedAuthPropertiesCertificateAuthorityArn))
+			}
+		}
+
+		_, err := conn.ModifyCertificateBasedAuthPropertiesWithContext(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) certificate-based authentication properties: %s", d.Id(), err)
+		}
+		log.Printf("[INFO] Modified WorkSpaces Directory (%s) certificate-based authentication properties", d.Id())
+	}
+
 	if d.HasChange("ip_group_ids") {
 		o, n := d.GetChange("ip_group_ids")
 		old := o.(*schema.Set)
@@ -592,6 +725,46 @@ func ExpandWorkspaceCreationProperties(properties []interface{}) *workspaces.Wor
 	return result
 }
 
+func ExpandSAMLProperties(properties []interface{}) *workspaces.SamlProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &workspaces.SamlProperties{
+		Status: aws.String(p["status"].(string)),
+	}
+
+	if p["relay_state_parameter_name"].(string) != "" {
+		result.RelayStateParameterName = aws.String(p["relay_state_parameter_name"].(string))
+	}
+
+	if p["user_access_url"].(string) != "" {
+		result.UserAccessUrl = aws.String(p["user_access_url"].(string))
+	}
+
+	return result
+}
+
+func ExpandCertificateBasedAuthProperties(properties []interface{}) *workspaces.CertificateBasedAuthProperties {
+	if len(properties) == 0 || properties[0] == nil {
+		return nil
+	}
+
+	p := properties[0].(map[string]interface{})
+
+	result := &workspaces.CertificateBasedAuthProperties{
+		Status: aws.String(p["status"].(string)),
+	}
+
+	if p["certificate_authority_arn"].(string) != "" {
+		result.CertificateAuthorityArn = aws.String(p["certificate_authority_arn"].(string))
+	}
+
+	return result
+}
+
 func FlattenWorkspaceAccessProperties(properties *workspaces.WorkspaceAccessProperties) []interface{} {
 	if properties == nil {
 		return []interface{}{}
@@ -681,3 +854,30 @@ func FlattenWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceC
 		},
 	}
 }
+
+func FlattenSAMLProperties(properties *workspaces.SamlProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"relay_state_parameter_name": aws.StringValue(properties.RelayStateParameterName),
+			"status":                     aws.StringValue(properties.Status),
+			"user_access_url":            aws.StringValue(properties.UserAccessUrl),
+		},
+	}
+}
+
+func FlattenCertificateBasedAuthProperties(properties *workspaces.CertificateBasedAuthProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_authority_arn": aws.StringValue(properties.CertificateAuthorityArn),
+			"status":                    aws.StringValue(properties.Status),
+		},
+	}
+}
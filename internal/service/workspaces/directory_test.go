@@ -245,6 +245,57 @@ func testAccDirectory_selfServicePermissions(t *testing.T) {
 	})
 }
 
+func testAccDirectory_samlProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v workspaces.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+
+	domain := acctest.RandomDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, workspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, "ENABLED", "RelayState"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.relay_state_parameter_name", "RelayState"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", fmt.Sprintf("https://sso.%s/", domain)),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.0.status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryConfig_samlProperties(rName, domain, "ENABLED_WITH_DIRECTORY_LOGIN_FALLBACK", "RelayStateUpdated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.relay_state_parameter_name", "RelayStateUpdated"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", "ENABLED_WITH_DIRECTORY_LOGIN_FALLBACK"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", fmt.Sprintf("https://sso.%s/", domain)),
+				),
+			},
+		},
+	})
+}
+
 func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v workspaces.WorkspaceDirectory
@@ -697,6 +748,164 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 	}
 }
 
+func TestExpandSAMLProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.SamlProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					"status":                     "ENABLED",
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+			expected: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  aws.String("ENABLED"),
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+		},
+		// Without user access URL
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					"status":                     "DISABLED",
+					"user_access_url":            "",
+				},
+			},
+			expected: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  aws.String("DISABLED"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenSAMLProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    *workspaces.SamlProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &workspaces.SamlProperties{
+				RelayStateParameterName: aws.String("RelayState"),
+				Status:                  aws.String("ENABLED"),
+				UserAccessUrl:           aws.String("https://sso.example.com/"),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"relay_state_parameter_name": "RelayState",
+					"status":                     "ENABLED",
+					"user_access_url":            "https://sso.example.com/",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenSAMLProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestExpandCertificateBasedAuthProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    []interface{}
+		expected *workspaces.CertificateBasedAuthProperties
+	}{
+		// Empty
+		{
+			input:    []interface{}{},
+			expected: nil,
+		},
+		// Full
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+					"status":                    "ENABLED",
+				},
+			},
+			expected: &workspaces.CertificateBasedAuthProperties{
+				CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				Status:                  aws.String("ENABLED"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.ExpandCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
+func TestFlattenCertificateBasedAuthProperties(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input    *workspaces.CertificateBasedAuthProperties
+		expected []interface{}
+	}{
+		// Empty
+		{
+			input:    nil,
+			expected: []interface{}{},
+		},
+		// Full
+		{
+			input: &workspaces.CertificateBasedAuthProperties{
+				CertificateAuthorityArn: aws.String("arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"), //lintignore:AWSAT003,AWSAT005
+				Status:                  aws.String("ENABLED"),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"certificate_authority_arn": "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012", //lintignore:AWSAT003,AWSAT005
+					"status":                    "ENABLED",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		actual := tfworkspaces.FlattenCertificateBasedAuthProperties(c.input)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("expected\n\n%#+v\n\ngot\n\n%#+v", c.expected, actual)
+		}
+	}
+}
+
 func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
@@ -908,6 +1117,26 @@ resource "aws_workspaces_directory" "main" {
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccDirectoryConfig_samlProperties(rName, domain, status, relayStateParameterName string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  saml_properties {
+    relay_state_parameter_name = %[3]q
+    status                     = %[2]q
+    user_access_url            = "https://sso.%[4]s/"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName, status, relayStateParameterName, domain))
+}
+
 func testAccDirectoryConfig_workspaceAccessProperties(rName, domain string) string {
 	return acctest.ConfigCompose(
 		testAccDirectoryConfig_Prerequisites(rName, domain),